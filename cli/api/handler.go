@@ -0,0 +1,201 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/go-store/store"
+)
+
+type setPairRequest struct {
+	Inaddr  string `json:"inaddr"`
+	Outaddr string `json:"outaddr"`
+	Wrapped bool   `json:"wrapped"`
+	URIFrom string `json:"uri_from"`
+	URITo   string `json:"uri_to"`
+	// registered without the checks against the chains
+	SkipChecks bool `json:"skip_checks"`
+}
+
+type retryResponse struct {
+	Hash string `json:"hash"`
+}
+
+// GET /status
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	status, err := s.bridge.Status()
+	if err != nil {
+		s.writeErr(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, status)
+}
+
+// GET /pairs, POST /pairs
+func (s *Server) handlePairs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		pairs, err := s.bridge.Repo.ListPairs()
+		if err != nil {
+			s.writeErr(w, err)
+			return
+		}
+		if pairs == nil {
+			pairs = []pb.Pair{}
+		}
+		writeJSON(w, http.StatusOK, pairs)
+
+	case http.MethodPost:
+		if !s.authorize(w, r) {
+			return
+		}
+
+		var req setPairRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		checker := s.bridge.PairChecker()
+		if req.SkipChecks {
+			s.logger.Warn().Msgf("the on-chain checks are skipped, in-addr: %s", req.Inaddr)
+			checker = bridge.SkipPairChecks
+		}
+
+		pair, err := bridge.SetPair(r.Context(), s.bridge.Repo, bridge.PairSpec{
+			Inaddr:  req.Inaddr,
+			Outaddr: req.Outaddr,
+			Wrapped: req.Wrapped,
+			URIFrom: req.URIFrom,
+			URITo:   req.URITo,
+		}, checker)
+		if err != nil {
+			s.writeErr(w, err)
+			return
+		}
+
+		s.logger.Info().Msgf("pair is set by api, pair: %v", pair)
+		writeJSON(w, http.StatusOK, pair)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	}
+}
+
+// GET /pairs/{inaddr}
+func (s *Server) handlePair(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	pair, err := bridge.GetPair(s.bridge.Repo, strings.TrimPrefix(r.URL.Path, "/pairs/"))
+	if err != nil {
+		s.writeErr(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pair)
+}
+
+// GET /events/{type}?status={status}, GET /events/{type}/{id}?bank={bank}, POST /events/{type}/{id}/retry?bank={bank}
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	paths := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/events/"), "/"), "/")
+
+	switch {
+	case len(paths) == 1 && r.Method == http.MethodGet:
+		s.listEvents(w, r, paths[0])
+
+	case len(paths) == 2 && r.Method == http.MethodGet:
+		e, err := s.parseEvent(r, paths[0], paths[1])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if err = s.bridge.Repo.GetEvent(e); err != nil {
+			s.writeErr(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, e)
+
+	case len(paths) == 3 && paths[2] == "retry" && r.Method == http.MethodPost:
+		if !s.authorize(w, r) {
+			return
+		}
+		e, err := s.parseEvent(r, paths[0], paths[1])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		hash, err := s.bridge.RetryEvent(r.Context(), e)
+		if err != nil {
+			s.writeErr(w, err)
+			return
+		}
+		s.logger.Info().Msgf("event is retried by api, hash: %s, event: %v", hash, e)
+		writeJSON(w, http.StatusOK, retryResponse{Hash: hash})
+
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no route for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) listEvents(w http.ResponseWriter, r *http.Request, typ string) {
+	var status *pb.EventStatus
+	if name := r.URL.Query().Get("status"); name != "" {
+		v, ok := pb.EventStatus_value[strings.ToUpper(name)]
+		if !ok {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("unexpected status: %s", name))
+			return
+		}
+		status = (*pb.EventStatus)(&v)
+	}
+
+	events, err := bridge.ListEvents(s.bridge.Repo, typ, status)
+	if err != nil {
+		s.writeErr(w, err)
+		return
+	}
+	if events == nil {
+		events = []pb.Event{}
+	}
+
+	writeJSON(w, http.StatusOK, events)
+}
+
+// parseEvent reads the bank by the query, which can be omitted while the bridge watches the single bank.
+// the id is the uint256 in decimal
+func (s *Server) parseEvent(r *http.Request, typ, id string) (pb.Event, error) {
+	return s.bridge.NewEvent(typ, r.URL.Query().Get("bank"), id)
+}
+
+// writeErr maps the bridge errors to the status code
+func (s *Server) writeErr(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, store.ErrNotFound), errors.Is(err, bridge.ErrEventNotFound), errors.Is(err, bridge.ErrPairNotFound), errors.Is(err, bridge.ErrUnknownEventType):
+		writeError(w, http.StatusNotFound, err)
+	case errors.Is(err, bridge.ErrInvalidAddress), errors.Is(err, bridge.ErrNotContract), errors.Is(err, bridge.ErrNotWhitelisted),
+		errors.Is(err, bridge.ErrMintUnsupported), errors.Is(err, bridge.ErrMintDenied):
+		writeError(w, http.StatusBadRequest, err)
+	case errors.Is(err, bridge.ErrEventSucceeded), errors.Is(err, bridge.ErrEventInflight), errors.Is(err, bridge.ErrPairDisabled),
+		errors.Is(err, bridge.ErrLowFunds), errors.Is(err, bridge.ErrNoPairChecker):
+		writeError(w, http.StatusConflict, err)
+	case errors.Is(err, db.ErrIterationUnsupported):
+		writeError(w, http.StatusNotImplemented, err)
+	default:
+		s.logger.Warn().Err(err).Msg("failed to handle api request")
+		writeError(w, http.StatusInternalServerError, err)
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tak1827/evm-bridge/cli/bridge"
+)
+
+type healthResponse struct {
+	Status string         `json:"status"`
+	Checks []bridge.Check `json:"checks"`
+}
+
+// GET /healthz, fails when the fetch loop is not progressing
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeChecks(w, s.bridge.Liveness(s.thresholds))
+}
+
+// GET /readyz, fails when the rpc, db, cursors or confirmer are unhealthy
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), DefaultHealthTimeout)
+	defer cancel()
+
+	writeChecks(w, s.bridge.Readiness(ctx, s.thresholds))
+}
+
+func writeChecks(w http.ResponseWriter, checks []bridge.Check) {
+	for _, c := range checks {
+		if !c.OK {
+			writeJSON(w, http.StatusServiceUnavailable, healthResponse{Status: "unhealthy", Checks: checks})
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, healthResponse{Status: "ok", Checks: checks})
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"github.com/tak1827/evm-bridge/cli/bridge"
+)
+
+type Option interface {
+	Apply(*Server) error
+}
+
+type Token string
+
+func (t Token) Apply(s *Server) error {
+	s.token = string(t)
+	return nil
+}
+func WithToken(token string) Token {
+	return Token(token)
+}
+
+type HealthThresholds bridge.HealthThresholds
+
+func (t HealthThresholds) Apply(s *Server) error {
+	s.thresholds = bridge.HealthThresholds(t)
+	return nil
+}
+func WithHealthThresholds(t bridge.HealthThresholds) HealthThresholds {
+	return HealthThresholds(t)
+}
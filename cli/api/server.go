@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/metrics"
+)
+
+const (
+	DefaultReadTimeout   = 10 * time.Second
+	DefaultWriteTimeout  = 30 * time.Second
+	DefaultHealthTimeout = 5 * time.Second
+	// the export of the whole db outlives the write timeout of the other endpoints
+	DefaultSnapshotTimeout = 30 * time.Minute
+)
+
+// connKey holds the connection in the context of its requests, so that the handler can extend its deadline
+type connKey struct{}
+
+var (
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrAdminDisabled = errors.New("admin api is disabled, set the api token to enable")
+)
+
+// Server serves the status of the running bridge over http.
+// the endpoints changing the state require the bearer token
+type Server struct {
+	bridge     *bridge.Bridge
+	token      string
+	thresholds bridge.HealthThresholds
+	logger     zerolog.Logger
+
+	mux *http.ServeMux
+	srv *http.Server
+}
+
+func NewServer(addr string, b *bridge.Bridge, opts ...Option) *Server {
+	s := &Server{
+		bridge:     b,
+		thresholds: bridge.DefaultHealthThresholds,
+		logger:     log.API(""),
+		mux:        http.NewServeMux(),
+	}
+
+	s.srv = &http.Server{
+		Addr:         addr,
+		Handler:      s.mux,
+		ReadTimeout:  DefaultReadTimeout,
+		WriteTimeout: DefaultWriteTimeout,
+		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+			return context.WithValue(ctx, connKey{}, c)
+		},
+	}
+
+	for i := 0; i < len(opts); i++ {
+		opts[i].Apply(s)
+	}
+
+	s.routes()
+
+	return s
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("/status", s.handleStatus)
+	s.mux.HandleFunc("/pairs", s.handlePairs)
+	s.mux.HandleFunc("/pairs/", s.handlePair)
+	s.mux.HandleFunc("/events/", s.handleEvents)
+	s.mux.HandleFunc("/db/snapshot", s.handleSnapshot)
+	s.mux.Handle("/metrics", metrics.Handler())
+	s.mux.HandleFunc("/healthz", s.handleHealthz)
+	s.mux.HandleFunc("/readyz", s.handleReadyz)
+}
+
+// Start listens the address, then serves in background
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error().Err(err).Msg("api server stopped")
+		}
+	}()
+
+	s.logger.Info().Msgf("api server is listening on %s", ln.Addr())
+	return nil
+}
+
+func (s *Server) Close(ctx context.Context) error {
+	return s.srv.Shutdown(ctx)
+}
+
+// authorize checks the bearer token, writing the error response when denied
+func (s *Server) authorize(w http.ResponseWriter, r *http.Request) bool {
+	if s.token == "" {
+		writeError(w, http.StatusForbidden, ErrAdminDisabled)
+		return false
+	}
+
+	given := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(given), []byte(s.token)) != 1 {
+		writeError(w, http.StatusUnauthorized, ErrUnauthorized)
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
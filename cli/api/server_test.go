@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/backup"
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/evm-bridge/cli/simulated"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+const (
+	AdminToken = "secret"
+)
+
+// newTestBridge starts the bridge of the simulated chain on the memory db
+func newTestBridge(t *testing.T) (*simulated.Harness, *bridge.Bridge) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := simulated.NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), bridge.WithBridgeContract(h.Bridge))
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	t.Cleanup(func() { b.Close(cancel, 0, false) })
+
+	return h, b
+}
+
+// serve records the response of the request, the body is sent as json unless nil
+func serve(s *Server, method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	var payload string
+	if body != nil {
+		b, _ := json.Marshal(body)
+		payload = string(b)
+	}
+
+	req := httptest.NewRequest(method, path, strings.NewReader(payload))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.mux.ServeHTTP(w, req)
+	return w
+}
+
+func TestStatus(t *testing.T) {
+	h, b := newTestBridge(t)
+	s := NewServer("", b)
+
+	w := serve(s, http.MethodGet, "/status", "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var st bridge.Status
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&st))
+	require.Equal(t, crypto.PubkeyToAddress(h.Relayer.PublicKey).Hex(), st.Signer)
+	require.Len(t, st.Banks, 1)
+	require.Equal(t, h.Bank.Hex(), st.Banks[0].Address)
+
+	w = serve(s, http.MethodPost, "/status", "", nil)
+	require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestPairs(t *testing.T) {
+	h, b := newTestBridge(t)
+	s := NewServer("", b, WithToken(AdminToken))
+
+	req := setPairRequest{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex()}
+
+	require.Equal(t, http.StatusForbidden, serve(NewServer("", b), http.MethodPost, "/pairs", AdminToken, req).Code)
+	require.Equal(t, http.StatusUnauthorized, serve(s, http.MethodPost, "/pairs", "", req).Code)
+	require.Equal(t, http.StatusUnauthorized, serve(s, http.MethodPost, "/pairs", "wrong", req).Code)
+	require.Equal(t, http.StatusMethodNotAllowed, serve(s, http.MethodDelete, "/pairs", AdminToken, nil).Code)
+	require.Equal(t, http.StatusMethodNotAllowed, serve(s, http.MethodDelete, "/pairs/"+h.ERC20In.Hex(), AdminToken, nil).Code)
+	require.Equal(t, http.StatusBadRequest, serve(s, http.MethodPost, "/pairs", AdminToken, "{").Code)
+
+	for _, tc := range []setPairRequest{
+		{Inaddr: "0x01", Outaddr: h.ERC20Out.Hex()},
+		{Inaddr: h.ERC20In.Hex(), Outaddr: "0x01"},
+		// not whitelisted yet
+		req,
+		{Inaddr: h.ERC20In.Hex(), Outaddr: h.Bank.Hex()},
+	} {
+		w := serve(s, http.MethodPost, "/pairs", AdminToken, tc)
+		require.Equal(t, http.StatusBadRequest, w.Code, "req: %v, body: %s", tc, w.Body)
+	}
+	w := serve(s, http.MethodGet, "/pairs", "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, "[]", w.Body.String())
+
+	require.NoError(t, h.Whitelist(context.Background()))
+	w = serve(s, http.MethodPost, "/pairs", AdminToken, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// registered without the checks explicitly
+	w = serve(s, http.MethodPost, "/pairs", AdminToken, setPairRequest{Inaddr: h.NFTIn.Hex(), Outaddr: h.Bank.Hex(), SkipChecks: true})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	w = serve(s, http.MethodGet, "/pairs/"+h.ERC20In.Hex(), "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var pair pb.Pair
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&pair))
+	require.Equal(t, h.ERC20Out.Hex(), pair.Outaddr)
+
+	require.Equal(t, http.StatusNotFound, serve(s, http.MethodGet, "/pairs/"+h.ERC20Out.Hex(), "", nil).Code)
+}
+
+func TestEvents(t *testing.T) {
+	h, b := newTestBridge(t)
+	s := NewServer("", b, WithToken(AdminToken))
+
+	ctx := context.Background()
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+	require.NoError(t, h.DepositERC20(ctx, 10))
+	bk := b.Banks[0]
+	_, err := b.FetchERC20(ctx, bk)
+	require.NoError(t, err)
+
+	// stored once the mint is confirmed
+	h.Mine(1)
+	require.Eventually(t, func() bool {
+		st, err := b.Status()
+		return err == nil && st.InflightERC20 == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	w := serve(s, http.MethodGet, "/events/erc20", "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var events []pb.EventERC20Deposited
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&events))
+	require.Len(t, events, 1)
+
+	w = serve(s, http.MethodGet, "/events/erc20/0?bank="+h.Bank.Hex(), "", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	var e pb.EventERC20Deposited
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&e))
+	require.Equal(t, "10", e.Amount)
+
+	for _, tc := range []struct {
+		method, path, token string
+		code                int
+	}{
+		{http.MethodGet, "/events/erc20?status=unknown", "", http.StatusBadRequest},
+		{http.MethodGet, "/events/unknown", "", http.StatusNotFound},
+		{http.MethodGet, "/events/erc20/abc", "", http.StatusBadRequest},
+		{http.MethodGet, "/events/erc20/1", "", http.StatusNotFound},
+		{http.MethodGet, "/events/erc20/0?bank=0x01", "", http.StatusBadRequest},
+		{http.MethodGet, "/events/erc20/0/1/2", "", http.StatusNotFound},
+		{http.MethodPost, "/events/erc20/0/retry", "", http.StatusUnauthorized},
+		// already succeeded
+		{http.MethodPost, "/events/erc20/0/retry", AdminToken, http.StatusConflict},
+	} {
+		w := serve(s, tc.method, tc.path, tc.token, nil)
+		require.Equal(t, tc.code, w.Code, "%s %s, body: %s", tc.method, tc.path, w.Body)
+	}
+}
+
+func TestHealthz(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := simulated.NewHarness()
+	require.NoError(t, err)
+	bank2, err := h.DeployBank()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+	rc2, err := rc.ForBank(bank2.Hex())
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), bridge.WithBanks(&rc2))
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	t.Cleanup(func() { b.Close(cancel, 0, false) })
+
+	// fresh since started
+	w := serve(NewServer("", b), http.MethodGet, "/healthz", "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var res healthResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Equal(t, "ok", res.Status)
+	require.Len(t, res.Checks, 4)
+
+	// only the erc20 pipeline of the second bank is fetched within the limit
+	th := bridge.DefaultHealthThresholds
+	th.MaxFetchStaleness = 200 * time.Millisecond
+	time.Sleep(th.MaxFetchStaleness)
+	_, err = b.FetchERC20(ctx, b.Banks[1])
+	require.NoError(t, err)
+
+	w = serve(NewServer("", b, WithHealthThresholds(th)), http.MethodGet, "/healthz", "", nil)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	res = healthResponse{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Equal(t, "unhealthy", res.Status)
+
+	stale := make(map[string]bool)
+	for _, c := range res.Checks {
+		stale[c.Name] = !c.OK
+	}
+	require.Equal(t, map[string]bool{
+		"fetch-erc20-" + h.Bank.Hex(): true,
+		"fetch-nft-" + h.Bank.Hex():   true,
+		"fetch-erc20-" + bank2.Hex():  false,
+		"fetch-nft-" + bank2.Hex():    true,
+	}, stale)
+}
+
+func TestReadyz(t *testing.T) {
+	h, b := newTestBridge(t)
+
+	w := serve(NewServer("", b), http.MethodGet, "/readyz", "", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	// the cursors lag behind the head beyond the limit
+	h.Mine(3)
+	th := bridge.DefaultHealthThresholds
+	th.MaxCursorLag = 1
+	w = serve(NewServer("", b, WithHealthThresholds(th)), http.MethodGet, "/readyz", "", nil)
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var res healthResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&res))
+	require.Equal(t, "unhealthy", res.Status)
+}
+
+func TestSnapshotSlowReader(t *testing.T) {
+	h, b := newTestBridge(t)
+
+	// far more than the socket buffers, so that the writes block until read
+	const events = 30000
+	for i := 0; i < events; i++ {
+		e := pb.EventERC20Deposited{Id: strconv.Itoa(i), Bank: h.Bank.Hex(), Token: h.ERC20In.Hex(), Sender: h.UserAddress().Hex(), Amount: "10", Status: pb.EventStatus_SUCCEEDED}
+		require.NoError(t, b.Repo.PutEvent(&e))
+	}
+
+	s := NewServer("127.0.0.1:0", b, WithToken(AdminToken))
+	s.srv.WriteTimeout = 100 * time.Millisecond
+	ln, err := net.Listen("tcp", s.srv.Addr)
+	require.NoError(t, err)
+	go s.srv.Serve(ln)
+	t.Cleanup(func() { s.Close(context.Background()) })
+
+	req, err := http.NewRequest(http.MethodGet, "http://"+ln.Addr().String()+"/db/snapshot", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+AdminToken)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// read beyond the write timeout of the other endpoints
+	time.Sleep(5 * s.srv.WriteTimeout)
+	sum, err := backup.Verify(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, events, sum.EventsERC20)
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/backup"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// GET /db/snapshot
+// streams the export of the point in time snapshot, the running bridge keeps writing meanwhile
+func (s *Server) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	if !s.authorize(w, r) {
+		return
+	}
+
+	sn, err := db.Snapshot(s.bridge.Repo.DB)
+	if err != nil {
+		s.writeErr(w, err)
+		return
+	}
+	defer sn.Close()
+
+	// the write deadline set by the server is replaced for this response only
+	if c, ok := r.Context().Value(connKey{}).(net.Conn); ok {
+		if err := c.SetWriteDeadline(time.Now().Add(DefaultSnapshotTimeout)); err != nil {
+			s.writeErr(w, err)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	// the status is already sent, so the failure is only logged, the import rejects the truncated export
+	sum, err := backup.Export(pb.NewRepository(sn), w)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("failed to export snapshot")
+		return
+	}
+	s.logger.Info().Msgf("exported snapshot, %s", sum)
+}
@@ -0,0 +1,197 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/go-store/store"
+)
+
+const (
+	ActionDepositObserved = "deposit.observed"
+	ActionMintSigned      = "mint.signed"
+	ActionMintConfirmed   = "mint.confirmed"
+	ActionMintRetried     = "mint.retried"
+	ActionMintFailed      = "mint.failed"
+	ActionEventPaused     = "event.paused"
+	ActionEventOverridden = "event.overridden" // retried by the operator
+	ActionPairSet         = "pair.set"
+	ActionPairDeleted     = "pair.deleted"
+	ActionPairDisabled    = "pair.disabled"
+	ActionPairEnabled     = "pair.enabled"
+	ActionNonceReset      = "nonce.reset"
+	ActionNonceCancelled  = "nonce.cancelled"
+	ActionMintingPaused   = "minting.paused"
+	ActionMintingResumed  = "minting.resumed"
+	ActionCursorSet       = "cursor.set"
+	ActionBlocksReplayed  = "blocks.replayed"
+
+	ActorBridge   = "bridge"
+	ActorOperator = "operator"
+)
+
+var (
+	ErrTampered = errors.New("audit log is tampered")
+
+	// the entries appended by the process are serialized, so that the seq never conflicts
+	mu sync.Mutex
+
+	// the secret of the hmac chaining the entries, the plain sha256 without it
+	key []byte
+)
+
+// SetKey sets the secret keying the chain, so that the log rewritten as a whole fails the verification without the secret.
+// set it at the start, before the entries are appended or verified
+func SetKey(secret []byte) {
+	key = secret
+}
+
+// Append chains the new entry to the last one. the details are sorted by key
+func Append(r *pb.Repository, actor, action, subject string, details ...pb.AuditDetail) (m pb.AuditEntry, err error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	prev, ok, err := tail(r)
+	if err != nil {
+		return
+	}
+
+	sort.SliceStable(details, func(i, j int) bool { return details[i].Key < details[j].Key })
+	m = pb.AuditEntry{
+		CreatedAt: time.Now().UTC(),
+		Action:    action,
+		Actor:     actor,
+		Subject:   subject,
+		Details:   details,
+	}
+	if ok {
+		m.Seq, m.PrevHash = prev.Seq+1, prev.Hash
+	}
+	if m.Hash, err = Hash(&m); err != nil {
+		return
+	}
+
+	err = r.PutAuditEntry(&m)
+	return
+}
+
+// Detail is the shorthand of the detail
+func Detail(key string, value interface{}) pb.AuditDetail {
+	return pb.AuditDetail{Key: key, Value: fmt.Sprint(value)}
+}
+
+// Hash returns the hmac-sha256 by the key of the entry without its hash, which contains the hash of the previous entry.
+// the plain sha256 when no key is set
+func Hash(m *pb.AuditEntry) ([]byte, error) {
+	c := *m
+	c.Hash = nil
+	b, err := c.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		h := sha256.Sum256(b)
+		return h[:], nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+	return mac.Sum(nil), nil
+}
+
+// tail returns the last entry, the entry written after the head is adopted,
+// since the head may not be moved by the crash right after the entry is written
+func tail(r *pb.Repository) (m pb.AuditEntry, ok bool, err error) {
+	seq, ok, err := r.AuditHead()
+	if err != nil {
+		return
+	}
+
+	if m, err = r.GetAuditEntry(seq); err != nil {
+		if errors.Is(err, store.ErrNotFound) && !ok {
+			return pb.AuditEntry{}, false, nil
+		}
+		return
+	}
+	for {
+		next, err := r.GetAuditEntry(m.Seq + 1)
+		if errors.Is(err, store.ErrNotFound) {
+			return m, true, nil
+		}
+		if err != nil {
+			return m, true, err
+		}
+		m = next
+	}
+}
+
+// Verifier checks the entries in order, each must link to the previous one and match its hash
+type Verifier struct {
+	prev  *pb.AuditEntry
+	Count uint64
+}
+
+func (v *Verifier) Add(m *pb.AuditEntry) error {
+	var expSeq uint64
+	var expPrev []byte
+	if v.prev != nil {
+		expSeq, expPrev = v.prev.Seq+1, v.prev.Hash
+	}
+
+	if m.Seq != expSeq {
+		return fmt.Errorf("%w: seq %d found, expected %d", ErrTampered, m.Seq, expSeq)
+	}
+	if !bytes.Equal(m.PrevHash, expPrev) {
+		return fmt.Errorf("%w: the previous hash of seq %d does not match", ErrTampered, m.Seq)
+	}
+	h, err := Hash(m)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(m.Hash, h) {
+		return fmt.Errorf("%w: the hash of seq %d does not match", ErrTampered, m.Seq)
+	}
+
+	c := *m
+	v.prev = &c
+	v.Count++
+	return nil
+}
+
+// Head returns the last verified entry, nil when none
+func (v *Verifier) Head() *pb.AuditEntry {
+	return v.prev
+}
+
+// Verify checks the whole log of the db, returns the last entry.
+// the head must point the last entry, so that the truncation is detected
+func Verify(r *pb.Repository) (head *pb.AuditEntry, count uint64, err error) {
+	var v Verifier
+	if err = r.IterateAuditLog(v.Add); err != nil {
+		return
+	}
+
+	seq, ok, err := r.AuditHead()
+	if err != nil {
+		return
+	}
+	if v.Head() == nil {
+		if ok {
+			err = fmt.Errorf("%w: the head points seq %d, but no entry found", ErrTampered, seq)
+		}
+		return
+	}
+
+	// the head can lag one behind by the crash between the writes
+	last := v.Head().Seq
+	if ok && seq != last && seq+1 != last || !ok && last != 0 {
+		err = fmt.Errorf("%w: the head points seq %d, but the last entry is seq %d", ErrTampered, seq, last)
+	}
+	return v.Head(), v.Count, err
+}
@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+func TestAuditLog(t *testing.T) {
+	repo := pb.NewRepository(db.NewMemDB())
+
+	head, count, err := Verify(repo)
+	require.NoError(t, err)
+	require.Nil(t, head)
+	require.Zero(t, count)
+
+	_, err = Append(repo, ActorBridge, ActionDepositObserved, "erc20/0", Detail("token", "0x01"), Detail("amount", 100))
+	require.NoError(t, err)
+	_, err = Append(repo, ActorBridge, ActionMintSigned, "erc20/0", Detail("nonce", 3), Detail("hash", "0xaa"))
+	require.NoError(t, err)
+	last, err := Append(repo, ActorOperator, ActionPairDisabled, "0x01")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), last.Seq)
+
+	head, count, err = Verify(repo)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+	require.Equal(t, last.Hash, head.Hash)
+
+	// the details are sorted
+	m, err := repo.GetAuditEntry(0)
+	require.NoError(t, err)
+	require.Equal(t, "amount", m.Details[0].Key)
+
+	// the export is verified offline
+	var buf bytes.Buffer
+	count, err = Export(repo, &buf)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+
+	head, count, err = VerifyExport(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+	require.Equal(t, last.Hash, head.Hash)
+
+	_, _, err = VerifyExport(strings.NewReader(strings.Replace(buf.String(), `"value":"100"`, `"value":"1000"`, 1)))
+	require.ErrorIs(t, err, ErrTampered)
+
+	// the altered entry
+	m.Details[0].Value = "1000"
+	value, err := m.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, repo.DB.Put(entryKey(m.Seq), value))
+	_, _, err = Verify(repo)
+	require.ErrorIs(t, err, ErrTampered)
+}
+
+func TestAuditLogTruncated(t *testing.T) {
+	repo := pb.NewRepository(db.NewMemDB())
+
+	for i := 0; i < 3; i++ {
+		_, err := Append(repo, ActorBridge, ActionMintConfirmed, "nft/0")
+		require.NoError(t, err)
+	}
+
+	// the head lagging one behind is the crash between the writes, the next append adopts the entry
+	require.NoError(t, repo.DB.Put(pb.KEY_AUDIT_HEAD, (&pb.AuditEntry{Seq: 1}).StoreKey()))
+	_, _, err := Verify(repo)
+	require.NoError(t, err)
+	m, err := Append(repo, ActorBridge, ActionMintConfirmed, "nft/1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), m.Seq)
+
+	// the removed tail
+	require.NoError(t, repo.DB.Delete(entryKey(m.Seq)))
+	require.NoError(t, repo.DB.Delete(entryKey(2)))
+	_, _, err = Verify(repo)
+	require.ErrorIs(t, err, ErrTampered)
+}
+
+func TestAuditLogRewritten(t *testing.T) {
+	SetKey([]byte("secret"))
+	t.Cleanup(func() { SetKey(nil) })
+
+	repo := pb.NewRepository(db.NewMemDB())
+	for i := 0; i < 3; i++ {
+		_, err := Append(repo, ActorOperator, ActionCursorSet, "erc20", Detail("block", i))
+		require.NoError(t, err)
+	}
+	_, _, err := Verify(repo)
+	require.NoError(t, err)
+
+	// the whole log is rechained with the altered entry, by the forger not knowing the secret
+	for _, forged := range [][]byte{nil, []byte("guessed")} {
+		SetKey(forged)
+		var prev []byte
+		for seq := uint64(0); seq < 3; seq++ {
+			m, err := repo.GetAuditEntry(seq)
+			require.NoError(t, err)
+			if seq == 1 {
+				m.Details[0].Value = "100"
+			}
+			m.PrevHash = prev
+			m.Hash, err = Hash(&m)
+			require.NoError(t, err)
+			require.NoError(t, repo.PutAuditEntry(&m))
+			prev = m.Hash
+		}
+		// consistent by the key of the forger
+		_, _, err = Verify(repo)
+		require.NoError(t, err)
+
+		SetKey([]byte("secret"))
+		_, _, err = Verify(repo)
+		require.ErrorIs(t, err, ErrTampered, "forged by %q", forged)
+	}
+}
+
+func entryKey(seq uint64) []byte {
+	m := pb.AuditEntry{Seq: seq}
+	return append(append([]byte{}, pb.PREFIX_AUDIT_LOG...), m.StoreKey()...)
+}
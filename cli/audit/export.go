@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// Record is the json line of the exported entry, the hashes are in hex
+type Record struct {
+	Seq       uint64           `json:"seq"`
+	CreatedAt time.Time        `json:"created_at"`
+	Action    string           `json:"action"`
+	Actor     string           `json:"actor"`
+	Subject   string           `json:"subject,omitempty"`
+	Details   []pb.AuditDetail `json:"details,omitempty"`
+	PrevHash  string           `json:"prev_hash,omitempty"`
+	Hash      string           `json:"hash"`
+}
+
+func ToRecord(m *pb.AuditEntry) Record {
+	return Record{
+		Seq:       m.Seq,
+		CreatedAt: m.CreatedAt,
+		Action:    m.Action,
+		Actor:     m.Actor,
+		Subject:   m.Subject,
+		Details:   m.Details,
+		PrevHash:  hex.EncodeToString(m.PrevHash),
+		Hash:      hex.EncodeToString(m.Hash),
+	}
+}
+
+// Entry decodes the hashes of the record
+func (rec Record) Entry() (m pb.AuditEntry, err error) {
+	m = pb.AuditEntry{
+		Seq:       rec.Seq,
+		CreatedAt: rec.CreatedAt,
+		Action:    rec.Action,
+		Actor:     rec.Actor,
+		Subject:   rec.Subject,
+		Details:   rec.Details,
+	}
+	if m.PrevHash, err = hex.DecodeString(rec.PrevHash); err != nil {
+		return
+	}
+	if len(m.PrevHash) == 0 {
+		m.PrevHash = nil
+	}
+	m.Hash, err = hex.DecodeString(rec.Hash)
+	return
+}
+
+// Export writes the entries as newline delimited json after verifying them, so that the export is never tampered one
+func Export(r *pb.Repository, w io.Writer) (count uint64, err error) {
+	var (
+		v   Verifier
+		enc = json.NewEncoder(w)
+	)
+	err = r.IterateAuditLog(func(m *pb.AuditEntry) error {
+		if err := v.Add(m); err != nil {
+			return err
+		}
+		return enc.Encode(ToRecord(m))
+	})
+	return v.Count, err
+}
+
+// VerifyExport checks the exported entries, returns the last one
+func VerifyExport(rd io.Reader) (head *pb.AuditEntry, count uint64, err error) {
+	var (
+		v  Verifier
+		sc = bufio.NewScanner(rd)
+	)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		var rec Record
+		if err = json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			return
+		}
+		m, err := rec.Entry()
+		if err != nil {
+			return v.Head(), v.Count, err
+		}
+		if err = v.Add(&m); err != nil {
+			return v.Head(), v.Count, err
+		}
+	}
+	return v.Head(), v.Count, sc.Err()
+}
@@ -0,0 +1,144 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/evm-bridge/cli/schema"
+)
+
+const (
+	KindHeader   = "header"
+	KindCursor   = "cursor"
+	KindPair     = "pair"
+	KindEvent    = "event"
+	KindAudit    = "audit"
+	KindDelivery = "delivery"
+	KindEnd      = "end"
+)
+
+var (
+	ErrNotEmpty      = errors.New("the db is not empty, import into a fresh home")
+	ErrInvalidRecord = errors.New("invalid record")
+)
+
+// Record is the line of the export, only the field of the kind is set.
+// the header is always the first line, and the end holding the counts is the last,
+// so that the truncated export is detected
+type Record struct {
+	Kind     string                  `json:"kind"`
+	Header   *Header                 `json:"header,omitempty"`
+	Cursor   *Cursor                 `json:"cursor,omitempty"`
+	Pair     *pb.Pair                `json:"pair,omitempty"`
+	ERC20    *pb.EventERC20Deposited `json:"erc20,omitempty"`
+	NFT      *pb.EventNFTDeposited   `json:"nft,omitempty"`
+	Audit    *audit.Record           `json:"audit,omitempty"`
+	Delivery *pb.WebhookDelivery     `json:"delivery,omitempty"`
+	End      *Summary                `json:"end,omitempty"`
+}
+
+type Header struct {
+	Schema    uint32    `json:"schema"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Cursor is the last confirmed block of the event type, empty bank in the exports before namespaced by the bank
+type Cursor struct {
+	Bank  string            `json:"bank,omitempty"`
+	Type  string            `json:"type"`
+	Block pb.ConfirmedBlock `json:"block"`
+}
+
+// Summary counts the exported or imported records
+type Summary struct {
+	Schema      uint32 `json:"schema"`
+	Cursors     int    `json:"cursors"`
+	Pairs       int    `json:"pairs"`
+	EventsERC20 int    `json:"events_erc20"`
+	EventsNFT   int    `json:"events_nft"`
+	AuditLog    int    `json:"audit_log"`
+	Deliveries  int    `json:"deliveries"`
+}
+
+func (s Summary) String() string {
+	return fmt.Sprintf("schema: %d, cursors: %d, pairs: %d, erc20 events: %d, nft events: %d, audit log: %d, deliveries: %d", s.Schema, s.Cursors, s.Pairs, s.EventsERC20, s.EventsNFT, s.AuditLog, s.Deliveries)
+}
+
+var cursorTypes = map[string]pb.BlockType{
+	pb.EventTypeERC20: pb.BlockERC20,
+	pb.EventTypeNFT:   pb.BlockNFT,
+}
+
+// Export writes all records of the repository as newline delimited json.
+// pass the repository of a snapshot when the db is being written
+func Export(r *pb.Repository, w io.Writer) (sum Summary, err error) {
+	enc := json.NewEncoder(w)
+
+	if sum.Schema, err = schema.Version(r.DB); err != nil {
+		return
+	}
+	if err = enc.Encode(Record{Kind: KindHeader, Header: &Header{Schema: sum.Schema, CreatedAt: time.Now().UTC()}}); err != nil {
+		return
+	}
+
+	if err = r.IterateConfirmedBlocks(func(bank string, t pb.BlockType, m *pb.ConfirmedBlock) error {
+		sum.Cursors++
+		return enc.Encode(Record{Kind: KindCursor, Cursor: &Cursor{Bank: bank, Type: t.EventType(), Block: *m}})
+	}); err != nil {
+		return
+	}
+
+	pairs, err := r.ListPairs()
+	if err != nil {
+		return
+	}
+	for i := range pairs {
+		if err = enc.Encode(Record{Kind: KindPair, Pair: &pairs[i]}); err != nil {
+			return
+		}
+		sum.Pairs++
+	}
+
+	if err = r.IterateEventsERC20(func(e *pb.EventERC20Deposited) error {
+		sum.EventsERC20++
+		return enc.Encode(Record{Kind: KindEvent, ERC20: e})
+	}); err != nil {
+		return
+	}
+
+	if err = r.IterateEventsNFT(func(e *pb.EventNFTDeposited) error {
+		sum.EventsNFT++
+		return enc.Encode(Record{Kind: KindEvent, NFT: e})
+	}); err != nil {
+		return
+	}
+
+	// in the order of seq, so that the chain is verified on import
+	if err = r.IterateAuditLog(func(m *pb.AuditEntry) error {
+		sum.AuditLog++
+		rec := audit.ToRecord(m)
+		return enc.Encode(Record{Kind: KindAudit, Audit: &rec})
+	}); err != nil {
+		return
+	}
+
+	// the deliveries not sent yet, so that the notifications survive the restore
+	deliveries, err := r.ListDeliveries()
+	if err != nil {
+		return
+	}
+	for i := range deliveries {
+		if err = enc.Encode(Record{Kind: KindDelivery, Delivery: &deliveries[i]}); err != nil {
+			return
+		}
+		sum.Deliveries++
+	}
+
+	err = enc.Encode(Record{Kind: KindEnd, End: &sum})
+	return
+}
@@ -0,0 +1,135 @@
+package backup
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/evm-bridge/cli/schema"
+)
+
+const (
+	// the max uint256, such as the hashed token id
+	maxUint256 = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+
+	token  = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	sender = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	bank   = "0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0"
+	bank2  = "0xe7f1725E7734CE288F8367e1Bb143E90bb3F0512"
+)
+
+func TestExportImport(t *testing.T) {
+	src := pb.NewRepository(db.NewMemDB())
+	_, err := schema.Migrations.Migrate(src.DB, schema.Env{}, false)
+	require.NoError(t, err)
+
+	require.NoError(t, src.PutConfirmedBlock(bank, pb.BlockERC20, pb.ConfirmedBlock{Number: 10, Hash: "0x01"}))
+	require.NoError(t, src.PutConfirmedBlock(bank, pb.BlockNFT, pb.ConfirmedBlock{Number: 20, Hash: "0x02"}))
+	require.NoError(t, src.PutPair(&pb.Pair{Inaddr: token, Outaddr: sender, Intype: pb.Pair_WRAPPED}))
+	require.NoError(t, src.PutEvent(&pb.EventERC20Deposited{Id: "1", Bank: bank, Token: token, Sender: sender, Amount: "100", Status: pb.EventStatus_SUCCEEDED}))
+	require.NoError(t, src.PutEvent(&pb.EventERC20Deposited{Id: "1", Bank: bank2, Token: token, Sender: sender, Amount: "200"}))
+	require.NoError(t, src.PutEvent(&pb.EventNFTDeposited{Id: maxUint256, Bank: bank, Token: token, Sender: sender, Tokenid: maxUint256, Retry: 1}))
+	for i := 0; i < 2; i++ {
+		_, err = audit.Append(src, audit.ActorBridge, audit.ActionDepositObserved, "erc20/1", audit.Detail("token", token))
+		require.NoError(t, err)
+	}
+	// pending, retried after the restore
+	delivery := pb.WebhookDelivery{Id: 1, Sink: "ops", Payload: []byte(`{"stage":"sent"}`), Attempts: 2, NextAttemptAt: time.Unix(1700000000, 0).UTC(), CreatedAt: time.Unix(1600000000, 0).UTC(), LastError: "timeout"}
+	require.NoError(t, src.PutDelivery(&delivery))
+
+	var buf bytes.Buffer
+	exported, err := Export(src, &buf)
+	require.NoError(t, err)
+
+	verified, err := Verify(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, exported, verified)
+
+	dst := pb.NewRepository(db.NewMemDB())
+	imported, err := Import(dst, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, exported, imported)
+	require.Equal(t, Summary{Schema: schema.Migrations.Latest(), Cursors: 2, Pairs: 1, EventsERC20: 2, EventsNFT: 1, AuditLog: 2, Deliveries: 1}, imported)
+
+	block, err := dst.GetConfirmedBlock(bank, pb.BlockERC20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), block.Number)
+	pair, err := dst.GetPair(token)
+	require.NoError(t, err)
+	require.Equal(t, pb.Pair_WRAPPED, pair.Intype)
+	nft := &pb.EventNFTDeposited{Id: maxUint256, Bank: bank}
+	require.NoError(t, dst.GetEvent(nft))
+	require.Equal(t, maxUint256, nft.Tokenid)
+	_, count, err := audit.Verify(dst)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	deliveries, err := dst.ListDeliveries()
+	require.NoError(t, err)
+	require.Equal(t, []pb.WebhookDelivery{delivery}, deliveries)
+
+	// the home already holding records is refused
+	_, err = Import(dst, bytes.NewReader(buf.Bytes()))
+	require.ErrorIs(t, err, ErrNotEmpty)
+	outbox := pb.NewRepository(db.NewMemDB())
+	require.NoError(t, outbox.PutDelivery(&delivery))
+	_, err = Import(outbox, bytes.NewReader(buf.Bytes()))
+	require.ErrorIs(t, err, ErrNotEmpty)
+}
+
+func TestImportInvalid(t *testing.T) {
+	header := `{"kind":"header","header":{"schema":1}}` + "\n"
+	end := "\n" + `{"kind":"end","end":{"schema":1,"pairs":1}}`
+	cases := map[string]string{
+		"no header":     `{"kind":"pair","pair":{"inaddr":"` + token + `","outaddr":"` + sender + `"}}`,
+		"bad address":   header + `{"kind":"pair","pair":{"inaddr":"0x01","outaddr":"` + sender + `"}}` + end,
+		"truncated":     header + `{"kind":"pair","pair":{"inaddr":"` + token + `","outaddr":"` + sender + `"}}`,
+		"count":         header + `{"kind":"cursor","cursor":{"type":"nft"}}` + end,
+		"bad amount":    header + `{"kind":"event","erc20":{"id":1,"token":"` + token + `","sender":"` + sender + `","amount":"1e3"}}` + end,
+		"duplicated":    header + strings.Repeat(`{"kind":"event","nft":{"id":1,"token":"`+token+`","sender":"`+sender+`"}}`+"\n", 2) + end,
+		"unknown field": header + `{"kind":"cursor","cursor":{"type":"erc20"},"extra":1}` + end,
+		"too new":       `{"kind":"header","header":{"schema":999}}`,
+		"bad id":        header + `{"kind":"event","nft":{"id":"0x01","token":"` + token + `","sender":"` + sender + `","tokenid":"1"}}` + end,
+		"bad tokenid":   header + `{"kind":"event","nft":{"id":1,"token":"` + token + `","sender":"` + sender + `","tokenid":"` + maxUint256 + `0"}}` + end,
+	}
+
+	for name, in := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := pb.NewRepository(db.NewMemDB())
+			_, err := Import(r, strings.NewReader(in))
+			require.Error(t, err)
+
+			// nothing is written
+			has, err := r.DB.Has(schema.KEY_SCHEMA_VERSION)
+			require.NoError(t, err)
+			require.False(t, has)
+		})
+	}
+}
+
+func TestImportLegacyIds(t *testing.T) {
+	// exported before uint256, the ids are the numbers
+	in := `{"kind":"header","header":{"schema":2}}
+{"kind":"event","nft":{"id":1,"bank":"` + bank + `","token":"` + token + `","sender":"` + sender + `","tokenid":3}}
+{"kind":"event","nft":{"legacy_id":2,"bank":"` + bank + `","token":"` + token + `","sender":"` + sender + `","legacy_tokenid":4}}
+{"kind":"end","end":{"schema":2,"events_nft":2}}`
+
+	r := pb.NewRepository(db.NewMemDB())
+	_, err := Import(r, strings.NewReader(in))
+	require.NoError(t, err)
+
+	for id, tokenid := range map[string]string{"1": "3", "2": "4"} {
+		nft := &pb.EventNFTDeposited{Id: id, Bank: bank}
+		require.NoError(t, r.GetEvent(nft))
+		require.Equal(t, tokenid, nft.Tokenid)
+	}
+
+	// nothing is left to the migration
+	results, err := schema.Migrations.Migrate(r.DB, schema.Env{}, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, results[0].Writes)
+}
@@ -0,0 +1,294 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/evm-bridge/cli/schema"
+)
+
+// Import loads the exported records into the empty repository.
+// all records are validated before the first write, so that the invalid export leaves the db untouched
+func Import(r *pb.Repository, rd io.Reader) (sum Summary, err error) {
+	if err = ensureEmpty(r); err != nil {
+		return
+	}
+
+	records, err := decode(rd)
+	if err != nil {
+		return
+	}
+	if err = validate(records); err != nil {
+		return
+	}
+
+	sum.Schema = records[0].Header.Schema
+	for _, rec := range records[1 : len(records)-1] {
+		switch rec.Kind {
+		case KindCursor:
+			err = r.PutConfirmedBlock(rec.Cursor.Bank, cursorTypes[rec.Cursor.Type], rec.Cursor.Block)
+			sum.Cursors++
+		case KindPair:
+			err = r.PutPair(rec.Pair)
+			sum.Pairs++
+		case KindAudit:
+			var m pb.AuditEntry
+			if m, err = rec.Audit.Entry(); err == nil {
+				err = r.PutAuditEntry(&m)
+			}
+			sum.AuditLog++
+		case KindDelivery:
+			err = r.PutDelivery(rec.Delivery)
+			sum.Deliveries++
+		case KindEvent:
+			if rec.ERC20 != nil {
+				err = r.PutEvent(rec.ERC20)
+				sum.EventsERC20++
+			} else {
+				err = r.PutEvent(rec.NFT)
+				sum.EventsNFT++
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+
+	// the version is written last, so that the interrupted import is not taken as complete
+	err = schema.SetVersion(r.DB, sum.Schema)
+	return
+}
+
+// Verify validates the export without loading, returns the counts of the records
+func Verify(rd io.Reader) (sum Summary, err error) {
+	records, err := decode(rd)
+	if err != nil {
+		return
+	}
+	if err = validate(records); err != nil {
+		return
+	}
+	sum = *records[len(records)-1].End
+	return
+}
+
+func ensureEmpty(r *pb.Repository) error {
+	has, err := r.DB.Has(schema.KEY_SCHEMA_VERSION)
+	if err != nil {
+		return err
+	}
+	if has {
+		return ErrNotEmpty
+	}
+
+	for _, prefix := range [][]byte{pb.PREFIX_CONFIRMED_BLOCK, pb.PREFIX_ADDR_PAIR, pb.PREFIX_EVENT_ERC20, pb.PREFIX_EVENT_NFT, pb.PREFIX_AUDIT_LOG, pb.PREFIX_WEBHOOK_OUTBOX} {
+		stop := errors.New("stop")
+		if err := db.Iterate(r.DB, prefix, func(key, value []byte) error {
+			return stop
+		}); err != nil {
+			if errors.Is(err, stop) {
+				return ErrNotEmpty
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decode(rd io.Reader) (records []Record, err error) {
+	dec := json.NewDecoder(rd)
+	dec.DisallowUnknownFields()
+
+	for line := 1; ; line++ {
+		var rec Record
+		if err = dec.Decode(&rec); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+				break
+			}
+			err = fmt.Errorf("%w, record %d: %s", ErrInvalidRecord, line, err.Error())
+			return
+		}
+		records = append(records, rec)
+	}
+	return
+}
+
+func validate(records []Record) error {
+	if len(records) == 0 || records[0].Kind != KindHeader || records[0].Header == nil {
+		return fmt.Errorf("%w, the first record must be the header", ErrInvalidRecord)
+	}
+	if v := records[0].Header.Schema; v > schema.Migrations.Latest() {
+		return fmt.Errorf("%w, export: %d, supported: %d", schema.ErrSchemaTooNew, v, schema.Migrations.Latest())
+	}
+
+	var (
+		cursors    = make(map[string]bool)
+		pairs      = make(map[string]bool)
+		erc20s     = make(map[string]bool)
+		nfts       = make(map[string]bool)
+		deliveries = make(map[uint64]bool)
+		chain      audit.Verifier
+	)
+
+	last := len(records) - 1
+	if records[last].Kind != KindEnd || records[last].End == nil {
+		return fmt.Errorf("%w, the last record must be the end, the export may be truncated", ErrInvalidRecord)
+	}
+
+	for i, rec := range records[1:last] {
+		if err := validateRecord(rec, cursors, pairs, erc20s, nfts, deliveries, &chain); err != nil {
+			return fmt.Errorf("%w, record %d: %s", ErrInvalidRecord, i+2, err.Error())
+		}
+	}
+
+	got := Summary{Schema: records[0].Header.Schema, Cursors: len(cursors), Pairs: len(pairs), EventsERC20: len(erc20s), EventsNFT: len(nfts), AuditLog: int(chain.Count), Deliveries: len(deliveries)}
+	if got != *records[last].End {
+		return fmt.Errorf("%w, the counts mismatch the end, got: %s, expected: %s", ErrInvalidRecord, got, *records[last].End)
+	}
+
+	return nil
+}
+
+// validateRecord detects the duplicates by the key in the bank, the bank is empty in the exports before namespaced
+func validateRecord(rec Record, cursors, pairs, erc20s, nfts map[string]bool, deliveries map[uint64]bool, chain *audit.Verifier) error {
+	switch rec.Kind {
+	case KindAudit:
+		if rec.Audit == nil {
+			return errors.New("no audit entry")
+		}
+		m, err := rec.Audit.Entry()
+		if err != nil {
+			return fmt.Errorf("invalid audit hash: %s", err.Error())
+		}
+		return chain.Add(&m)
+
+	case KindDelivery:
+		m := rec.Delivery
+		if m == nil {
+			return errors.New("no delivery")
+		}
+		if m.Sink == "" {
+			return fmt.Errorf("no sink of delivery(%d)", m.Id)
+		}
+		if deliveries[m.Id] {
+			return fmt.Errorf("duplicated delivery(%d)", m.Id)
+		}
+		deliveries[m.Id] = true
+
+	case KindCursor:
+		if rec.Cursor == nil {
+			return errors.New("no cursor")
+		}
+		if _, ok := cursorTypes[rec.Cursor.Type]; !ok {
+			return fmt.Errorf("unexpected cursor type(%s)", rec.Cursor.Type)
+		}
+		if !validBank(rec.Cursor.Bank) {
+			return fmt.Errorf("invalid cursor bank(%s)", rec.Cursor.Bank)
+		}
+		key := rec.Cursor.Bank + "/" + rec.Cursor.Type
+		if cursors[key] {
+			return fmt.Errorf("duplicated cursor(%s)", key)
+		}
+		cursors[key] = true
+
+	case KindPair:
+		p := rec.Pair
+		if p == nil {
+			return errors.New("no pair")
+		}
+		if !common.IsHexAddress(p.Inaddr) || !common.IsHexAddress(p.Outaddr) {
+			return fmt.Errorf("invalid pair address(%s, %s)", p.Inaddr, p.Outaddr)
+		}
+		if !validPairType(p.Intype) || !validPairType(p.Outtype) {
+			return fmt.Errorf("invalid pair type(%d, %d)", p.Intype, p.Outtype)
+		}
+		if pairs[p.Inaddr] {
+			return fmt.Errorf("duplicated pair(%s)", p.Inaddr)
+		}
+		pairs[p.Inaddr] = true
+
+	case KindEvent:
+		switch {
+		case rec.ERC20 != nil && rec.NFT == nil:
+			e := rec.ERC20
+			if !common.IsHexAddress(e.Token) || !common.IsHexAddress(e.Sender) {
+				return fmt.Errorf("invalid erc20 event address(%s, %s)", e.Token, e.Sender)
+			}
+			// the exports before uint256 may carry the uint64 ids, the ids are normalized as keyed
+			pb.MoveLegacyIds(e)
+			id, err := pb.ParseUint256(e.Id)
+			if err != nil {
+				return fmt.Errorf("invalid erc20 event id(%s)", e.Id)
+			}
+			e.Id = id.String()
+			if _, err := pb.ParseUint256(e.Amount); err != nil {
+				return fmt.Errorf("invalid erc20 event amount(%s)", e.Amount)
+			}
+			if !validStatus(e.Status) {
+				return fmt.Errorf("invalid erc20 event status(%d)", e.Status)
+			}
+			if !validBank(e.Bank) {
+				return fmt.Errorf("invalid erc20 event bank(%s)", e.Bank)
+			}
+			key := fmt.Sprintf("%s/%s", e.Bank, e.Id)
+			if erc20s[key] {
+				return fmt.Errorf("duplicated erc20 event(%s)", key)
+			}
+			erc20s[key] = true
+		case rec.NFT != nil && rec.ERC20 == nil:
+			e := rec.NFT
+			if !common.IsHexAddress(e.Token) || !common.IsHexAddress(e.Sender) {
+				return fmt.Errorf("invalid nft event address(%s, %s)", e.Token, e.Sender)
+			}
+			pb.MoveLegacyIds(e)
+			id, err := pb.ParseUint256(e.Id)
+			if err != nil {
+				return fmt.Errorf("invalid nft event id(%s)", e.Id)
+			}
+			e.Id = id.String()
+			if _, err := pb.ParseUint256(e.Tokenid); err != nil {
+				return fmt.Errorf("invalid nft event tokenid(%s)", e.Tokenid)
+			}
+			if !validStatus(e.Status) {
+				return fmt.Errorf("invalid nft event status(%d)", e.Status)
+			}
+			if !validBank(e.Bank) {
+				return fmt.Errorf("invalid nft event bank(%s)", e.Bank)
+			}
+			key := fmt.Sprintf("%s/%s", e.Bank, e.Id)
+			if nfts[key] {
+				return fmt.Errorf("duplicated nft event(%s)", key)
+			}
+			nfts[key] = true
+		default:
+			return errors.New("the event must be either erc20 or nft")
+		}
+
+	default:
+		return fmt.Errorf("unexpected kind(%s)", rec.Kind)
+	}
+
+	return nil
+}
+
+func validBank(bank string) bool {
+	return bank == "" || common.IsHexAddress(bank)
+}
+
+func validPairType(t pb.Pair_Type) bool {
+	_, ok := pb.Pair_Type_name[int32(t)]
+	return ok
+}
+
+func validStatus(s pb.EventStatus) bool {
+	_, ok := pb.EventStatus_name[int32(s)]
+	return ok
+}
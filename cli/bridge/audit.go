@@ -0,0 +1,37 @@
+package bridge
+
+import (
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// audit records the action of the bridge, the failure is logged but never stops the bridge
+func (b *Bridge) audit(action, subject string, details ...pb.AuditDetail) {
+	if _, err := audit.Append(b.Repo, audit.ActorBridge, action, subject, details...); err != nil {
+		b.logger.Error().Msgf("failed to append audit log(%s), subject: %s, err: %v", action, subject, err)
+	}
+}
+
+func eventDetails(e pb.Event, details ...pb.AuditDetail) []pb.AuditDetail {
+	details = append(details, audit.Detail("token", e.GetToken()), audit.Detail("retry", e.GetRetry()))
+	switch v := e.(type) {
+	case *pb.EventERC20Deposited:
+		details = append(details, audit.Detail("sender", v.Sender), audit.Detail("amount", v.Amount))
+	case *pb.EventNFTDeposited:
+		details = append(details, audit.Detail("sender", v.Sender), audit.Detail("tokenid", v.Tokenid))
+		if v.TokenUri != "" {
+			details = append(details, audit.Detail("token_uri", v.TokenUri))
+		}
+	}
+	return details
+}
+
+func pairDetails(pair pb.Pair) []pb.AuditDetail {
+	return []pb.AuditDetail{
+		audit.Detail("outaddr", pair.Outaddr),
+		audit.Detail("intype", pair.Intype),
+		audit.Detail("disabled", pair.Disabled),
+		audit.Detail("uri_from", pair.UriFrom),
+		audit.Detail("uri_to", pair.UriTo),
+	}
+}
@@ -0,0 +1,140 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/metrics"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+const (
+	BALANCE_CHECK_TIMEOUT = 10 * time.Second
+)
+
+var (
+	DefaultBalancePolicy = BalancePolicy{
+		Interval: 1 * time.Minute,
+	}
+)
+
+// BalancePolicy decides when the low balance of the relayer is warned and the minting is paused.
+// the thresholds are in wei, nil disables them
+type BalancePolicy struct {
+	Interval time.Duration
+	// warned below the balance
+	WarnBelow *big.Int
+	// the minting is paused below the balance, resumed once topped up
+	PauseBelow *big.Int
+}
+
+func (p BalancePolicy) Validate() error {
+	if p.Interval <= 0 {
+		return fmt.Errorf("invalid balance policy: interval(%s) must be positive", p.Interval)
+	}
+	if p.WarnBelow != nil && p.PauseBelow != nil && p.WarnBelow.Cmp(p.PauseBelow) < 0 {
+		return fmt.Errorf("invalid balance policy: warn-below(%s) is lower than pause-below(%s)", p.WarnBelow, p.PauseBelow)
+	}
+	return nil
+}
+
+func (p BalancePolicy) enabled() bool {
+	return p.WarnBelow != nil || p.PauseBelow != nil
+}
+
+// balanceState is the last checked balance of the relayer
+type balanceState struct {
+	sync.RWMutex
+
+	value     *big.Int
+	checkedAt time.Time
+	low       bool // the minting is paused
+}
+
+// runBalanceCheck checks the balance every interval until the context is done
+func (b *Bridge) runBalanceCheck(ctx context.Context) {
+	ticker := time.NewTicker(b.balancePolicy.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cctx, cancel := context.WithTimeout(ctx, BALANCE_CHECK_TIMEOUT)
+			if err := b.CheckBalance(cctx); err != nil {
+				b.logger.Warn().Msgf("failed to check balance, err: %v", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// CheckBalance reads the balance of the relayer, then pauses or resumes the minting by the policy
+func (b *Bridge) CheckBalance(ctx context.Context) error {
+	balance, err := b.client.BalanceAt(ctx, b.wallet.Address())
+	if err != nil {
+		return err
+	}
+	b.setBalance(balance, time.Now())
+	return nil
+}
+
+func (b *Bridge) setBalance(balance *big.Int, now time.Time) {
+	value, _ := new(big.Float).SetInt(balance).Float64()
+	metrics.SignerBalance.Set(value)
+
+	p := b.balancePolicy
+	low := p.PauseBelow != nil && balance.Cmp(p.PauseBelow) < 0
+
+	b.balance.Lock()
+	wasLow := b.balance.low
+	b.balance.value, b.balance.checkedAt, b.balance.low = balance, now, low
+	b.balance.Unlock()
+
+	switch {
+	case low && !wasLow:
+		b.logger.Error().Msgf("minting is paused, the relayer balance %s is below %s, top up %s", balance, p.PauseBelow, b.wallet.Address().Hex())
+		metrics.MintingPaused.Set(1)
+		b.audit(audit.ActionMintingPaused, b.wallet.Address().Hex(), audit.Detail("balance", balance), audit.Detail("pause_below", p.PauseBelow))
+	case !low && wasLow:
+		b.logger.Info().Msgf("minting is resumed, the relayer balance %s", balance)
+		metrics.MintingPaused.Set(0)
+		b.audit(audit.ActionMintingResumed, b.wallet.Address().Hex(), audit.Detail("balance", balance))
+	case p.WarnBelow != nil && balance.Cmp(p.WarnBelow) < 0:
+		b.logger.Warn().Msgf("the relayer balance %s is below %s, top up %s", balance, p.WarnBelow, b.wallet.Address().Hex())
+	}
+}
+
+// MintingPaused reports whether the minting is paused by the low balance
+func (b *Bridge) MintingPaused() bool {
+	b.balance.RLock()
+	defer b.balance.RUnlock()
+
+	return b.balance.low
+}
+
+// holdForFunds delays the event until the next balance check without consuming the retry
+func (b *Bridge) holdForFunds(h string, e pb.Event, err error) {
+	b.balance.Lock()
+	wasLow := b.balance.low
+	b.balance.low = true
+	b.balance.Unlock()
+	metrics.MintingPaused.Set(1)
+	if !wasLow {
+		b.audit(audit.ActionMintingPaused, b.wallet.Address().Hex(), audit.Detail("error", err))
+	}
+
+	at := time.Now().Add(b.balancePolicy.Interval).UTC()
+	e.SetNextAttemptAt(&at)
+	if perr := b.Repo.PutEvent(e); perr != nil {
+		b.logger.Warn().Msgf("failed to put event(%v), hash: %s, err: %v", e, h, perr)
+	}
+	b.retries.push(e, at)
+
+	b.logger.Warn().Msgf("held event(%v) until topped up, hash: %s, err: %v", e, h, err)
+}
@@ -0,0 +1,81 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+func TestBalancePolicy(t *testing.T) {
+	require.NoError(t, DefaultBalancePolicy.Validate())
+	require.False(t, DefaultBalancePolicy.enabled())
+	require.Error(t, BalancePolicy{}.Validate())
+	require.Error(t, BalancePolicy{Interval: time.Second, WarnBelow: big.NewInt(1), PauseBelow: big.NewInt(2)}.Validate())
+}
+
+func TestLowFunds(t *testing.T) {
+	var (
+		b = &Bridge{
+			Repo:          pb.NewRepository(db.NewMemDB()),
+			logger:        log.Bridge(""),
+			detectedAt:    make(map[string]time.Time),
+			retryPolicies: make(map[string]RetryPolicy),
+			retries:       newRetryQueue(),
+			balancePolicy: BalancePolicy{Interval: time.Minute, WarnBelow: big.NewInt(200), PauseBelow: big.NewInt(100)},
+		}
+		now     = time.Now()
+		priv, _ = crypto.GenerateKey()
+	)
+	b.wallet.priv = priv
+
+	b.setBalance(big.NewInt(150), now)
+	require.False(t, b.MintingPaused())
+
+	b.setBalance(big.NewInt(99), now)
+	require.True(t, b.MintingPaused())
+
+	_, err := b.FetchERC20(context.Background(), &Bank{})
+	require.ErrorIs(t, err, ErrLowFunds)
+
+	// the paused pipeline is alive
+	var (
+		done = make(chan struct{})
+		th   = HealthThresholds{MaxFetchStaleness: time.Hour}
+	)
+	b.Banks = []*Bank{{}}
+	b.lastFetchedAt = map[pipelineKey]time.Time{{typ: pb.EventTypeERC20}: now.Add(-2 * time.Hour), {typ: pb.EventTypeNFT}: now}
+	b.EventMapERC20, b.EventMapNFT, b.pipelines = make(map[string]*pb.EventERC20Deposited), make(map[string]*pb.EventNFTDeposited), newPipelines()
+	require.False(t, b.Liveness(th)[0].OK)
+	b.startPipeline(context.Background(), done, pipeline{typ: pb.EventTypeERC20, cursor: &pb.ConfirmedBlock{}}, time.Millisecond)
+	require.Eventually(t, func() bool { return b.Liveness(th)[0].OK }, time.Second, time.Millisecond)
+	close(done)
+	b.Wait()
+
+	b.setBalance(big.NewInt(100), now)
+	require.False(t, b.MintingPaused())
+
+	// the insufficient funds holds the event without consuming the retry
+	e := &pb.EventERC20Deposited{Id: "1", Retry: 1}
+	b.handleFailure("", e, client.Classify(errors.New("insufficient funds for gas * price + value")))
+	require.True(t, b.MintingPaused())
+	require.Equal(t, 1, b.retries.Len())
+
+	stored := &pb.EventERC20Deposited{Id: "1"}
+	require.NoError(t, b.Repo.GetEvent(stored))
+	require.Equal(t, uint32(1), stored.Retry)
+	require.NotNil(t, stored.NextAttemptAt)
+	require.NotEqual(t, pb.EventStatus_PAUSED, stored.Status)
+
+	// the due retries wait until resumed
+	b.sendRetries(context.Background(), now.Add(time.Hour))
+	require.Equal(t, 1, b.retries.Len())
+}
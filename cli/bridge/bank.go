@@ -0,0 +1,98 @@
+package bridge
+
+import (
+	"fmt"
+
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// Bank is the deposit contract watched by the bridge. the event id is unique only in the bank,
+// so the events and the cursors are namespaced by its address
+type Bank struct {
+	Address string
+
+	client *client.ReadClient
+
+	ConfirmedBlockERC20 pb.ConfirmedBlock
+	ConfirmedBlockNFT   pb.ConfirmedBlock
+}
+
+func newBank(rc *client.ReadClient) *Bank {
+	return &Bank{
+		Address: rc.BankAddress().Hex(),
+		client:  rc,
+	}
+}
+
+// cursor returns the confirmed block of the type, should be called holding the lock when running
+func (bk *Bank) cursor(typ string) *pb.ConfirmedBlock {
+	if typ == pb.EventTypeNFT {
+		return &bk.ConfirmedBlockNFT
+	}
+	return &bk.ConfirmedBlockERC20
+}
+
+// addBanks appends the banks watched in addition to the first one
+func (b *Bridge) addBanks(rcs ...*client.ReadClient) error {
+	for _, rc := range rcs {
+		bk := newBank(rc)
+		if _, ok := b.bank(bk.Address); ok {
+			return fmt.Errorf("%w, bank: %s", ErrDuplicatedBank, bk.Address)
+		}
+		b.Banks = append(b.Banks, bk)
+	}
+	return nil
+}
+
+func (b *Bridge) bank(addr string) (*Bank, bool) {
+	for _, bk := range b.Banks {
+		if bk.Address == addr {
+			return bk, true
+		}
+	}
+	return nil, false
+}
+
+// loadCursors reads the confirmed blocks of the banks
+func (b *Bridge) loadCursors() (err error) {
+	for _, bk := range b.Banks {
+		if bk.ConfirmedBlockERC20, err = b.Repo.GetConfirmedBlock(bk.Address, pb.BlockERC20); err != nil {
+			return
+		}
+		if bk.ConfirmedBlockNFT, err = b.Repo.GetConfirmedBlock(bk.Address, pb.BlockNFT); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// lowestCursors returns the confirmed blocks furthest behind of all banks, should be called holding the lock
+func (b *Bridge) lowestCursors() (erc20, nft uint64) {
+	for i, bk := range b.Banks {
+		if i == 0 || bk.ConfirmedBlockERC20.Number < erc20 {
+			erc20 = bk.ConfirmedBlockERC20.Number
+		}
+		if i == 0 || bk.ConfirmedBlockNFT.Number < nft {
+			nft = bk.ConfirmedBlockNFT.Number
+		}
+	}
+	return
+}
+
+// NewEvent returns the empty event of the bank, which can be filled by `Repository.GetEvent`.
+// the bank can be omitted while the bridge watches the single bank
+func (b *Bridge) NewEvent(typ, bank, id string) (pb.Event, error) {
+	switch {
+	case bank == "" && len(b.Banks) == 1:
+		bank = b.Banks[0].Address
+	case bank == "":
+		return nil, ErrBankRequired
+	default:
+		var err error
+		if bank, err = bankAddress(bank); err != nil {
+			return nil, err
+		}
+	}
+	return pb.NewEvent(typ, bank, id)
+}
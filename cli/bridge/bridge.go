@@ -12,9 +12,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog"
+	"github.com/tak1827/evm-bridge/cli/audit"
 	"github.com/tak1827/evm-bridge/cli/client"
 	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/metrics"
 	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/evm-bridge/cli/schema"
 	"github.com/tak1827/go-store/store"
 	"github.com/tak1827/transaction-confirmer/confirm"
 )
@@ -22,62 +25,128 @@ import (
 type Bridge struct {
 	sync.Mutex
 
-	client      *client.Client
+	client *client.Client
+	// the client of the first bank, queried for the in chain
 	reaadClient *client.ReadClient
 
-	DB store.Store
+	Repo *pb.Repository
 
 	wallet    Wallet
 	confirmer *confirm.Confirmer
 	logger    zerolog.Logger
-
-	// TODO: cache of addr pair
+	notifier  notifier
+	pairs     pairCache
+
+	retryPolicies map[string]RetryPolicy
+	retries       *retryQueue
+	nonceSync     NonceSyncPolicy
+	nonces        nonceTracker
+	untracked     untracked
+	balancePolicy BalancePolicy
+	balance       balanceState
+	pipelines     pipelines
+	dryRun        dryRun
+	// the pairs are checked against it, nil when not configured
+	bridgeContract *common.Address
 
 	CustomConfirmedHandler confirm.HashHandler
 	CustomErrHandler       confirm.ErrHandler
 
-	EventMapERC20       map[string]*pb.EventERC20Deposited
-	EventMapNFT         map[string]*pb.EventNFTDeposited
-	ConfirmedBlockERC20 pb.ConfirmedBlock
-	ConfirmedBlockNFT   pb.ConfirmedBlock
+	EventMapERC20 map[string]*pb.EventERC20Deposited
+	EventMapNFT   map[string]*pb.EventNFTDeposited
+	// the watched banks, the records stored before namespaced belong to the first one
+	Banks []*Bank
+
+	// the time of the first detection by event key, measuring the confirmation latency
+	detectedAt map[string]time.Time
+	// the time of the last successful fetch by the pipeline
+	lastFetchedAt map[pipelineKey]time.Time
 }
 
-func NewBridge(ctx context.Context, c *client.Client, rc *client.ReadClient, confirmer *confirm.Confirmer, privKey string, path string, opts ...Option) (b *Bridge, err error) {
+// NewBridge creates the bridge owning the db, which is closed by `Close`.
+// the bank of the read client is watched, add the others by `WithBanks`
+func NewBridge(ctx context.Context, c *client.Client, rc *client.ReadClient, confirmer *confirm.Confirmer, privKey string, db store.Store, opts ...Option) (b *Bridge, err error) {
 	b = &Bridge{
 		client:        c,
 		reaadClient:   rc,
+		Repo:          pb.NewRepository(db),
 		confirmer:     confirmer,
 		logger:        log.Bridge(""),
 		EventMapERC20: make(map[string]*pb.EventERC20Deposited),
 		EventMapNFT:   make(map[string]*pb.EventNFTDeposited),
+		detectedAt:    make(map[string]time.Time),
+		retryPolicies: make(map[string]RetryPolicy),
+		retries:       newRetryQueue(),
+		nonceSync:     DefaultNonceSyncPolicy,
+		nonces:        newNonceTracker(),
+		untracked:     newUntracked(),
+		balancePolicy: DefaultBalancePolicy,
+		pipelines:     newPipelines(),
+		Banks:         []*Bank{newBank(rc)},
+		lastFetchedAt: make(map[pipelineKey]time.Time),
 	}
 
+	b.confirmer.AfterTxSent = b.sentHandler
 	b.confirmer.AfterTxConfirmed = b.confirmedHandler
 	b.confirmer.ErrHandler = b.confirmerErrHandler
 
-	if b.DB, err = store.NewLevelDB(path); err != nil {
-		return
+	for i := 0; i < len(opts); i++ {
+		if err = opts[i].Apply(b); err != nil {
+			return
+		}
 	}
+
+	// the banks added by the options are fresh until the first fetch is due
+	for _, bk := range b.Banks {
+		for _, typ := range []string{pb.EventTypeERC20, pb.EventTypeNFT} {
+			b.lastFetchedAt[pipelineKey{bank: bk.Address, typ: typ}] = time.Now()
+		}
+	}
+
 	if b.wallet, err = NewWallet(ctx, c, privKey); err != nil {
 		return
 	}
-	if err = b.ConfirmedBlockERC20.Get(b.DB, pb.BlockERC20); err != nil {
+	if err = b.migrate(); err != nil {
 		return
 	}
-	if err = b.ConfirmedBlockNFT.Get(b.DB, pb.BlockNFT); err != nil {
+	if err = b.syncPairs(); err != nil {
 		return
 	}
-
-	for i := 0; i < len(opts); i++ {
-		opts[i].Apply(b)
+	if err = b.loadRetries(); err != nil {
+		return
 	}
-
+	err = b.loadCursors()
 	return
 }
 
+// migrate brings the db schema up to date before any record is read
+func (b *Bridge) migrate() error {
+	results, err := schema.Migrations.Migrate(b.Repo.DB, schema.Env{Bank: b.Banks[0].Address}, false)
+	if err != nil {
+		return fmt.Errorf("failed to migrate db: %w", err)
+	}
+	for _, r := range results {
+		b.logger.Info().Msgf("migrated db to version %d(%s), writes: %d, deletes: %d", r.Migration.Version, r.Migration.Name, r.Writes, r.Deletes)
+	}
+	return nil
+}
+
 func (b *Bridge) Start(ctx context.Context) (err error) {
 	b.logger.Info().Msg("bridge is starting...")
-	err = b.confirmer.Start(ctx)
+	if err = b.confirmer.Start(ctx); err != nil {
+		return
+	}
+	go b.runRetries(ctx)
+	// the gaps are filled by the txs, never sent in the dry run
+	if b.nonceSync.Interval > 0 && !b.dryRun.enabled {
+		go b.runNonceSync(ctx)
+	}
+	if b.balancePolicy.enabled() {
+		if err := b.CheckBalance(ctx); err != nil {
+			b.logger.Warn().Msgf("failed to check balance, err: %v", err)
+		}
+		go b.runBalanceCheck(ctx)
+	}
 	return
 }
 
@@ -105,18 +174,20 @@ func (b *Bridge) Close(cancel context.CancelFunc, retryLimit int, commitStarts b
 	}
 
 	if commitStarts {
-		if err := b.ConfirmedBlockERC20.Put(b.DB, pb.BlockERC20); err != nil {
-			b.logger.Warn().Msgf("faild to put ConfirmedBlockERC20(%v)", b.ConfirmedBlockERC20)
-		}
-		if err := b.ConfirmedBlockNFT.Put(b.DB, pb.BlockNFT); err != nil {
-			b.logger.Warn().Msgf("faild to put ConfirmedBlockNFT(%v)", b.ConfirmedBlockNFT)
+		for _, bk := range b.Banks {
+			if err := b.Repo.PutConfirmedBlock(bk.Address, pb.BlockERC20, bk.ConfirmedBlockERC20); err != nil {
+				b.logger.Warn().Msgf("faild to put ConfirmedBlockERC20(%v) of bank(%s)", bk.ConfirmedBlockERC20, bk.Address)
+			}
+			if err := b.Repo.PutConfirmedBlock(bk.Address, pb.BlockNFT, bk.ConfirmedBlockNFT); err != nil {
+				b.logger.Warn().Msgf("faild to put ConfirmedBlockNFT(%v) of bank(%s)", bk.ConfirmedBlockNFT, bk.Address)
+			}
+			b.logger.Info().Msgf("commited the last confirmed blocks of bank(%s), erc20: %d, nft: %d", bk.Address, bk.ConfirmedBlockERC20.Number, bk.ConfirmedBlockNFT.Number)
 		}
-		b.logger.Info().Msgf("commited the last confirmed blocks, erc20: %d, nft: %d", b.ConfirmedBlockERC20.Number, b.ConfirmedBlockNFT.Number)
 	}
 
 	b.confirmer.Close(cancel)
 
-	if err := b.DB.Close(); err != nil {
+	if err := b.Repo.Close(); err != nil {
 		b.logger.Warn().Msg("faild to close db")
 	}
 }
@@ -128,72 +199,114 @@ func (b *Bridge) canClose() bool {
 	return len(b.EventMapERC20) == 0 && len(b.EventMapNFT) == 0
 }
 
-func (b *Bridge) FetchERC20(ctx context.Context) (uint64, error) {
-	eventCh := make(chan pb.Event, 256)
+func (b *Bridge) FetchERC20(ctx context.Context, bk *Bank) (uint64, error) {
+	// the logs are fetched after resumed, the cursor is kept meanwhile
+	if b.MintingPaused() {
+		return 0, ErrLowFunds
+	}
 
-	end, err := b.reaadClient.LatestBlockNumber(ctx)
+	end, err := bk.client.LatestBlockNumber(ctx)
 	if err != nil {
 		return 0, err
 	}
+	if err = b.filterLogs(ctx, bk, pb.EventTypeERC20, bk.ConfirmedBlockERC20.Number, end); err != nil {
+		return 0, err
+	}
 
-	go func() {
-		defer close(eventCh)
-
-		if err = b.reaadClient.FilterERC20Deposited(ctx, b.ConfirmedBlockERC20.Number, &end, func(e *client.IBankERC20Deposited) error {
-			eventCh <- pb.ToEventERC20Deposited(e)
-			return nil
-		}); err != nil {
-			b.logger.Warn().Msgf("failed filter erc20 logs, err: %v", err)
-		}
-	}()
-
-	err = b.handleLogs(ctx, eventCh)
-	return end, err
+	b.markFetched(bk.Address, pb.EventTypeERC20)
+	return end, nil
 }
 
-func (b *Bridge) FetchNFT(ctx context.Context) (uint64, error) {
+func (b *Bridge) FetchNFT(ctx context.Context, bk *Bank) (uint64, error) {
+	if b.MintingPaused() {
+		return 0, ErrLowFunds
+	}
+
 	var (
-		eventCh  = make(chan pb.Event, 256)
-		start    = b.ConfirmedBlockNFT.Number
-		end, err = b.reaadClient.LatestBlockNumber(ctx)
+		start    = bk.ConfirmedBlockNFT.Number
+		end, err = bk.client.LatestBlockNumber(ctx)
 	)
 	if err != nil {
 		return 0, err
 	}
+	if err = b.filterLogs(ctx, bk, pb.EventTypeNFT, start, end); err != nil {
+		return 0, err
+	}
+
+	b.markFetched(bk.Address, pb.EventTypeNFT)
+	return end, nil
+}
+
+// filterLogs handles the deposits of the type logged by the bank in the range, including the both ends
+func (b *Bridge) filterLogs(ctx context.Context, bk *Bank, typ string, start, end uint64) error {
+	eventCh := make(chan pb.Event, 256)
 
+	var filterErr error
 	go func() {
 		defer close(eventCh)
 
-		if err = b.reaadClient.FilterNFTDeposited(ctx, start, &end, func(e *client.IBankNFTDeposited) error {
-			eventCh <- pb.ToEventNFTDeposited(e)
-			return nil
-		}); err != nil {
-			b.logger.Warn().Msgf("failed filter nft logs, err: %v", err)
+		switch typ {
+		case pb.EventTypeERC20:
+			filterErr = bk.client.FilterERC20Deposited(ctx, start, &end, func(e *client.IBankERC20Deposited) error {
+				eventCh <- pb.ToEventERC20Deposited(e)
+				return nil
+			})
+		case pb.EventTypeNFT:
+			filterErr = bk.client.FilterNFTDeposited(ctx, start, &end, func(e *client.IBankNFTDeposited) error {
+				eventCh <- pb.ToEventNFTDeposited(e)
+				return nil
+			})
+		default:
+			filterErr = fmt.Errorf("%w: %s", ErrUnknownEventType, typ)
+		}
+		if filterErr != nil {
+			b.logger.Warn().Msgf("failed filter %s logs of bank(%s), err: %v", typ, bk.Address, filterErr)
 		}
 	}()
 
-	err = b.handleLogs(ctx, eventCh)
-	return end, err
+	// the channel is drained by handleLogs, so that the filter error is visible here
+	if err := b.handleLogs(ctx, eventCh); err != nil {
+		return err
+	}
+	return filterErr
 }
 
+// handleLogs sends the events, the channel is always drained, so that the sender is never blocked
 func (b *Bridge) handleLogs(ctx context.Context, eventCh chan pb.Event) error {
+	defer func() {
+		for range eventCh {
+		}
+	}()
+
+	if err := b.syncPairs(); err != nil {
+		return err
+	}
+
 	for e := range eventCh {
 		b.logger.Info().Msgf("handling event: %v", e)
+		metrics.EventsFetched.WithLabelValues(e.Type(), e.GetToken()).Inc()
 
-		if err := e.Get(b.DB); err != nil {
+		if err := b.Repo.GetEvent(e); err != nil {
 			if !errors.Is(err, store.ErrNotFound) {
 				return err
 			}
 		} else if e.GetStatus() == pb.EventStatus_SUCCEEDED {
 			continue
+		} else if e.GetNextAttemptAt() != nil {
+			// owned by the retry queue
+			continue
 		}
 
+		b.publish(StageDetected, "", e, nil)
+		b.audit(audit.ActionDepositObserved, eventKey(e), eventDetails(e)...)
+
 		if _, err := b.send(ctx, e); err != nil {
 			if errors.Is(err, ErrPairNotFound) {
 				b.logger.Warn().Msgf("pir not found, event: %v, err: %v", e, err)
 				continue
 			}
-			return err
+			// the failure of the single event does not stop the fetch
+			b.handleFailure("", e, err)
 		}
 	}
 
@@ -201,19 +314,33 @@ func (b *Bridge) handleLogs(ctx context.Context, eventCh chan pb.Event) error {
 }
 
 func (b *Bridge) send(ctx context.Context, e pb.Event) (hash string, err error) {
-	pair, err := pb.GetPair(b.DB, e.GetToken())
-	if err != nil {
+	pair, ok := b.getPair(e.GetToken())
+	if !ok {
 		err = ErrPairNotFound
 		return
 	}
+	if pair.Disabled {
+		err = fmt.Errorf("%w, in-addr: %s", ErrPairDisabled, pair.Inaddr)
+		return
+	}
 
 	var (
 		tx *types.Transaction
 		to = common.HexToAddress(pair.Outaddr)
 	)
+
+	if v, ok := e.(*pb.EventNFTDeposited); ok && v.TokenUri == "" {
+		if err = b.readTokenURI(ctx, v); err != nil {
+			return
+		}
+	}
+
+	b.nonces.Lock()
+	defer b.nonces.Unlock()
+
 	switch pair.Intype {
 	case pb.Pair_ORIGINAL:
-		if tx, err = b.mint(ctx, e, to); err != nil {
+		if tx, err = b.mint(ctx, e, pair); err != nil {
 			return
 		}
 	case pb.Pair_WRAPPED:
@@ -221,35 +348,142 @@ func (b *Bridge) send(ctx context.Context, e pb.Event) (hash string, err error)
 	}
 
 	hash = tx.Hash().Hex()
+	// never sent, so the nonce is kept in sync with the chain
+	if b.dryRun.enabled {
+		b.reportDryRun(e, pair, tx)
+		return
+	}
+	b.wallet.UseNonce(tx.Nonce())
+	// written before enqueued, so that the confirmed tx is always found
 	b.writeEventMap(hash, e)
 
-	err = b.confirmer.EnqueueTx(ctx, tx)
+	b.untracked.sent = ""
+	if err = b.confirmer.EnqueueTx(ctx, tx); err != nil {
+		if b.untracked.sent != hash {
+			// not in flight, the pipeline and the retry are not blocked by the unsent tx
+			b.deleteEventMap(hash)
+			return
+		}
+		// already on chain, so that failing the event would mint it twice by the retry
+		b.logger.Warn().Msgf("failed to enqueue the sent tx, polling the receipt, hash: %s, err: %v", hash, err)
+		b.track(hash)
+		err = nil
+	}
+	b.nonces.hashes[tx.Nonce()] = hash
+	b.audit(audit.ActionMintSigned, eventKey(e), eventDetails(e,
+		audit.Detail("hash", hash),
+		audit.Detail("nonce", tx.Nonce()),
+		audit.Detail("gas", tx.Gas()),
+		audit.Detail("gas_price", tx.GasPrice()),
+		audit.Detail("to", to.Hex()),
+	)...)
+
+	b.publish(StageSent, hash, e, nil)
 	return
 }
 
-func (b *Bridge) mint(ctx context.Context, e pb.Event, to common.Address) (tx *types.Transaction, err error) {
-	nonce, err := b.wallet.IncrementNonce()
+// RetryEvent resends the stored event which is not succeeded yet, resetting its retry count
+func (b *Bridge) RetryEvent(ctx context.Context, e pb.Event) (hash string, err error) {
+	if err = b.Repo.GetEvent(e); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			err = ErrEventNotFound
+		}
+		return
+	}
+
+	if e.GetStatus() == pb.EventStatus_SUCCEEDED {
+		err = ErrEventSucceeded
+		return
+	}
+
+	if b.isInflight(e) {
+		err = ErrEventInflight
+		return
+	}
+
+	if b.MintingPaused() {
+		err = ErrLowFunds
+		return
+	}
+
+	if err = b.syncPairs(); err != nil {
+		return
+	}
+
+	b.retries.remove(e)
+
+	e.SetRetry(0)
+	e.SetStatus(pb.EventStatus_UNDEFINED)
+	e.SetNextAttemptAt(nil)
+
+	b.logger.Info().Msgf("retrying event: %v", e)
+	if _, err = audit.Append(b.Repo, audit.ActorOperator, audit.ActionEventOverridden, eventKey(e), eventDetails(e)...); err != nil {
+		return
+	}
+
+	return b.send(ctx, e)
+}
+
+func (b *Bridge) mint(ctx context.Context, e pb.Event, pair pb.Pair) (tx *types.Transaction, err error) {
+	var value *big.Int
+	switch v := e.(type) {
+	case *pb.EventERC20Deposited:
+		if value, err = pb.ParseUint256(v.Amount); err != nil {
+			return nil, fmt.Errorf("%w, amount: %s", err, v.Amount)
+		}
+	case *pb.EventNFTDeposited:
+		if value, err = pb.ParseUint256(v.Tokenid); err != nil {
+			return nil, fmt.Errorf("%w, tokenid: %s", err, v.Tokenid)
+		}
+	default:
+		panic(fmt.Sprintf("unexpected type(%T)\n", v))
+	}
+
+	// consumed by the caller once built, the failed estimate leaves no gap
+	nonce, err := b.wallet.NextNonce()
 	if err != nil {
 		return
 	}
 
+	to := common.HexToAddress(pair.Outaddr)
 	switch v := e.(type) {
 	case *pb.EventERC20Deposited:
 		sender := common.HexToAddress(v.Sender)
-		amount := new(big.Int)
-		amount.SetString(v.Amount, 10)
-		tx, err = b.client.BuildERC20MintTx(ctx, b.wallet.priv, nonce, to, sender, amount)
+		tx, err = b.client.BuildERC20MintTx(ctx, b.wallet.priv, nonce, to, sender, value)
 	case *pb.EventNFTDeposited:
 		sender := common.HexToAddress(v.Sender)
-		tokenid := big.NewInt(int64(v.Tokenid))
-		tx, err = b.client.BuildNFTMintTx(ctx, b.wallet.priv, nonce, to, sender, tokenid)
-	default:
-		panic(fmt.Sprintf("unexpected type(%T)\n", v))
+		tx, err = b.client.BuildNFTMintTx(ctx, b.wallet.priv, nonce, to, sender, value, pair.RewriteURI(v.TokenUri))
 	}
 
 	return
 }
 
+// readTokenURI reads the token uri of the in chain nft by the client of the bank, kept empty when not supported.
+// stored on the event, so that the retries mint the same uri
+func (b *Bridge) readTokenURI(ctx context.Context, e *pb.EventNFTDeposited) error {
+	rc := b.reaadClient
+	if bk, ok := b.bank(e.Bank); ok {
+		rc = bk.client
+	}
+
+	tokenid, err := pb.ParseUint256(e.Tokenid)
+	if err != nil {
+		return fmt.Errorf("%w, tokenid: %s", err, e.Tokenid)
+	}
+
+	uri, err := rc.TokenURI(ctx, common.HexToAddress(e.Token), tokenid)
+	if errors.Is(err, client.ErrNoTokenURI) {
+		b.logger.Debug().Msgf("token uri is not supported, token: %s", e.Token)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	e.TokenUri = uri
+	return nil
+}
+
 func (b *Bridge) confirmedHandler(h string) (err error) {
 	if b.CustomConfirmedHandler != nil {
 		if err = b.CustomConfirmedHandler(h); err != nil {
@@ -265,11 +499,18 @@ func (b *Bridge) confirmedHandler(h string) (err error) {
 
 	e.SetStatus(pb.EventStatus_SUCCEEDED)
 
-	if err = e.Put(b.DB); err != nil {
+	if err = b.Repo.PutEvent(e); err != nil {
 		return
 	}
 
+	metrics.EventsMinted.WithLabelValues(e.Type(), e.GetToken()).Inc()
+	if detected, ok := b.popDetectedAt(e); ok {
+		metrics.ConfirmationLatency.WithLabelValues(e.Type()).Observe(time.Since(detected).Seconds())
+	}
+
 	b.logger.Info().Msgf("confirmed, hash: %s, event: %v", h, e)
+	b.audit(audit.ActionMintConfirmed, eventKey(e), eventDetails(e, audit.Detail("hash", h))...)
+	b.publish(StageSucceeded, h, e, nil)
 
 	return
 }
@@ -285,20 +526,7 @@ func (b *Bridge) confirmerErrHandler(h string, err error) {
 	}
 	b.deleteEventMap(h)
 
-	if e.GetRetry() >= 3 || !errors.Is(err, confirm.ErrTxFailed) {
-		b.logger.Warn().Msgf("failed handle erc20 log(%v), hash: %s, err: %v", e, h, err)
-		e.SetStatus(pb.EventStatus_FAILED)
-		if err = e.Put(b.DB); err != nil {
-			b.logger.Warn().Msgf("failed to put event(%v), hash: %s, err: %v", e, h, err)
-		}
-		return
-	}
-
-	e.SetRetry(e.GetRetry() + 1)
-
-	if _, err = b.send(context.Background(), e); err != nil {
-		b.logger.Warn().Msgf("failed mint event(%v), hash: %s, err: %v", e, h, err)
-	}
+	b.handleFailure(h, e, err)
 }
 
 func (b *Bridge) writeEventMap(h string, e pb.Event) {
@@ -313,6 +541,12 @@ func (b *Bridge) writeEventMap(h string, e pb.Event) {
 	default:
 		panic(fmt.Sprintf("unexpected type(%T)\n", v))
 	}
+
+	if _, ok := b.detectedAt[eventKey(e)]; !ok {
+		b.detectedAt[eventKey(e)] = time.Now()
+	}
+
+	b.updateInflightMetrics()
 }
 
 func (b *Bridge) readEventMap(h string) (e pb.Event, exist bool) {
@@ -330,19 +564,68 @@ func (b *Bridge) readEventMap(h string) (e pb.Event, exist bool) {
 	return
 }
 
+func (b *Bridge) isInflight(e pb.Event) bool {
+	b.Lock()
+	defer b.Unlock()
+
+	switch e.(type) {
+	case *pb.EventERC20Deposited:
+		for _, v := range b.EventMapERC20 {
+			if eventKey(v) == eventKey(e) {
+				return true
+			}
+		}
+	case *pb.EventNFTDeposited:
+		for _, v := range b.EventMapNFT {
+			if eventKey(v) == eventKey(e) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (b *Bridge) deleteEventMap(h string) {
 	b.Lock()
 	defer b.Unlock()
 
-	exist := false
-	if _, exist = b.EventMapERC20[h]; exist {
+	defer b.updateInflightMetrics()
+
+	if e, exist := b.EventMapERC20[h]; exist {
 		delete(b.EventMapERC20, h)
+		if b.countInflight(e.Bank, pb.EventTypeERC20) == 0 {
+			b.signalIdle(e.Bank, pb.EventTypeERC20)
+		}
 		return
 	}
-	if _, exist = b.EventMapNFT[h]; exist {
+	if e, exist := b.EventMapNFT[h]; exist {
 		delete(b.EventMapNFT, h)
+		if b.countInflight(e.Bank, pb.EventTypeNFT) == 0 {
+			b.signalIdle(e.Bank, pb.EventTypeNFT)
+		}
 		return
 	}
+}
 
+func (b *Bridge) popDetectedAt(e pb.Event) (t time.Time, ok bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	key := eventKey(e)
+	if t, ok = b.detectedAt[key]; ok {
+		delete(b.detectedAt, key)
+	}
 	return
 }
+
+// updateInflightMetrics should be called holding the lock
+func (b *Bridge) updateInflightMetrics() {
+	metrics.Inflight.WithLabelValues(pb.EventTypeERC20).Set(float64(len(b.EventMapERC20)))
+	metrics.Inflight.WithLabelValues(pb.EventTypeNFT).Set(float64(len(b.EventMapNFT)))
+}
+
+// eventKey identifies the event across the banks
+func eventKey(e pb.Event) string {
+	return fmt.Sprintf("%s/%s/%s", e.Type(), e.GetBank(), e.GetId())
+}
@@ -3,6 +3,7 @@ package bridge
 import (
 	"context"
 	"crypto/ecdsa"
+	"math/big"
 	"strconv"
 	"sync"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/db"
 	"github.com/tak1827/evm-bridge/cli/pb"
 	"github.com/tak1827/transaction-confirmer/confirm"
 )
@@ -33,9 +35,8 @@ const (
 func TestHandleLogs(t *testing.T) {
 	var (
 		// ctx, cancel = context.WithCancel(context.Background())
-		ctx     = context.Background()
-		rotator = NewRotator(2)
-		pairs   = []pb.Pair{
+		ctx   = context.Background()
+		pairs = []pb.Pair{
 			pb.Pair{
 				Inaddr:  ERC20Hex,
 				Outaddr: ERC20Hex,
@@ -61,12 +62,13 @@ func TestHandleLogs(t *testing.T) {
 
 	confirmer := confirm.NewConfirmer(&c, QueueSize, confirm.WithWorkers(2), confirm.WithWorkerInterval(100))
 
-	bridge, err := NewBridge(ctx, &c, &rc, &confirmer, PrivKey, "")
+	bridge, err := NewBridge(ctx, &c, &rc, &confirmer, PrivKey, db.NewMemDB())
 	require.NoError(t, err)
 	require.NoError(t, bridge.Start(ctx))
+	bank := bridge.Banks[0]
 
 	for _, pair := range pairs {
-		require.NoError(t, pair.Put(bridge.DB))
+		require.NoError(t, bridge.Repo.PutPair(&pair))
 	}
 
 	bridge.CustomConfirmedHandler = func(h string) (err error) {
@@ -87,13 +89,16 @@ func TestHandleLogs(t *testing.T) {
 		breakERC20   = false
 		breakNFT     = false
 		tokenid      int64
+		turn         int
 	)
 	for {
 		<-timer.C
 		incrementBlock(t, bridge, ctx, priv2, 3)
 
-		switch rotator.Rotate() {
-		case SlotERC20:
+		// the erc20 and the nft take turns
+		turn++
+		switch turn % 2 {
+		case 1:
 			if !(len(bridge.EventMapERC20) == 0) {
 				continue
 			}
@@ -106,10 +111,10 @@ func TestHandleLogs(t *testing.T) {
 
 			batchDepositERC20(t, bridge, ctx, expectedAmount, 3)
 
-			bridge.ConfirmedBlockERC20.Number, err = bridge.FetchERC20(ctx)
+			bank.ConfirmedBlockERC20.Number, err = bridge.FetchERC20(ctx, bank)
 			require.NoError(t, err)
 
-		case SlotNFT:
+		case 0:
 			if !(len(bridge.EventMapNFT) == 0) {
 				continue
 			}
@@ -122,11 +127,8 @@ func TestHandleLogs(t *testing.T) {
 
 			tokenid = batchDepositNFT(t, bridge, ctx, tokenid, 3)
 
-			bridge.ConfirmedBlockNFT.Number, err = bridge.FetchNFT(ctx)
+			bank.ConfirmedBlockNFT.Number, err = bridge.FetchNFT(ctx, bank)
 			require.NoError(t, err)
-
-		default:
-			panic("unexpexted slot")
 		}
 
 		if breakERC20 && breakNFT {
@@ -134,12 +136,12 @@ func TestHandleLogs(t *testing.T) {
 		}
 	}
 
-	require.NoError(t, bridge.ConfirmedBlockERC20.Put(bridge.DB, pb.BlockERC20))
-	require.NoError(t, bridge.ConfirmedBlockNFT.Put(bridge.DB, pb.BlockNFT))
+	require.NoError(t, bridge.Repo.PutConfirmedBlock(bank.Address, pb.BlockERC20, bank.ConfirmedBlockERC20))
+	require.NoError(t, bridge.Repo.PutConfirmedBlock(bank.Address, pb.BlockNFT, bank.ConfirmedBlockNFT))
 
-	tokenids := make(map[uint64]struct{})
+	tokenids := make(map[string]struct{})
 	for _, e := range sentTxs {
-		require.NoError(t, e.Get(bridge.DB))
+		require.NoError(t, bridge.Repo.GetEvent(e))
 		require.Equal(t, pb.EventStatus_SUCCEEDED, e.GetStatus())
 
 		switch v := e.(type) {
@@ -154,11 +156,12 @@ func TestHandleLogs(t *testing.T) {
 		}
 	}
 
-	var block pb.ConfirmedBlock
-	require.NoError(t, block.Get(bridge.DB, pb.BlockERC20))
+	block, err := bridge.Repo.GetConfirmedBlock(bank.Address, pb.BlockERC20)
+	require.NoError(t, err)
 	require.Equal(t, true, block.Number > 0)
 
-	require.NoError(t, block.Get(bridge.DB, pb.BlockNFT))
+	block, err = bridge.Repo.GetConfirmedBlock(bank.Address, pb.BlockNFT)
+	require.NoError(t, err)
 	require.Equal(t, true, block.Number > 0)
 
 	// bridge.Close(cancel, 0, true)
@@ -173,14 +176,16 @@ func TestRetry(t *testing.T) {
 			Intype:  pb.Pair_ORIGINAL,
 		}
 		m           sync.Mutex
-		retryFlg    = make(map[uint64]uint32)
+		retryFlg    = make(map[string]uint32)
 		sentCounter uint32
 		amount      = int64(10)
 		priv2, _    = crypto.HexToECDSA(PrivKey2)
 		c, _        = client.NewClient(ctx, Endpoint, BankHex)
 		rc, _       = client.NewReadClient(ctx, Endpoint, BankHex)
 		confirmer   = confirm.NewConfirmer(&c, QueueSize, confirm.WithWorkers(2), confirm.WithWorkerInterval(100))
-		bridge, _   = NewBridge(ctx, &c, &rc, &confirmer, PrivKey, "")
+		policy      = RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, Multiplier: 1, Retryable: []string{ErrClassTxFailed}}
+		bridge, _   = NewBridge(ctx, &c, &rc, &confirmer, PrivKey, db.NewMemDB(), WithRetryPolicy(pb.EventTypeERC20, policy))
+		bank        = bridge.Banks[0]
 		err         error
 	)
 
@@ -218,7 +223,7 @@ func TestRetry(t *testing.T) {
 	}
 
 	bridge.Start(ctx)
-	pair.Put(bridge.DB)
+	bridge.Repo.PutPair(&pair)
 
 	timer := time.NewTicker(100 * time.Millisecond)
 	defer timer.Stop()
@@ -228,7 +233,7 @@ func TestRetry(t *testing.T) {
 	)
 	for {
 		<-timer.C
-		if !bridge.canClose() {
+		if !bridge.canClose() || bridge.retries.Len() > 0 {
 			incrementBlock(t, bridge, ctx, priv2, 3)
 			continue
 		}
@@ -239,15 +244,15 @@ func TestRetry(t *testing.T) {
 
 		batchDepositERC20(t, bridge, ctx, amount, 3)
 
-		bridge.ConfirmedBlockERC20.Number, err = bridge.FetchERC20(ctx)
+		bank.ConfirmedBlockERC20.Number, err = bridge.FetchERC20(ctx, bank)
 		require.NoError(t, err)
 
 		counter++
 	}
 
 	for id, retry := range retryFlg {
-		event := &pb.EventERC20Deposited{Id: id}
-		event.Get(bridge.DB)
+		event := &pb.EventERC20Deposited{Id: id, Bank: bank.Address}
+		bridge.Repo.GetEvent(event)
 		require.Equal(t, retry, event.Retry)
 		if retry >= 3 {
 			require.Equal(t, pb.EventStatus_FAILED, event.Status)
@@ -278,7 +283,7 @@ func batchDepositERC20(t *testing.T, bridge *Bridge, ctx context.Context, amount
 func batchDepositNFT(t *testing.T, bridge *Bridge, ctx context.Context, tokenid, size int64) (id int64) {
 	token := common.HexToAddress(NFTHexIn)
 	for id = tokenid; id < tokenid+size; id++ {
-		_, err := bridge.client.DepositNFT(ctx, bridge.wallet.priv, nil, token, id)
+		_, err := bridge.client.DepositNFT(ctx, bridge.wallet.priv, nil, token, big.NewInt(id))
 		require.NoError(t, err)
 	}
 	return
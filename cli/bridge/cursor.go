@@ -0,0 +1,97 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// GetCursor returns the confirmed block of the type of the bank, from which the next fetch starts
+func GetCursor(r *pb.Repository, bank, typ string) (pb.ConfirmedBlock, error) {
+	t, err := blockType(typ)
+	if err != nil {
+		return pb.ConfirmedBlock{}, err
+	}
+	if bank, err = bankAddress(bank); err != nil {
+		return pb.ConfirmedBlock{}, err
+	}
+	return r.GetConfirmedBlock(bank, t)
+}
+
+// SetCursor resets the confirmed block of the type of the bank, then returns the previous one.
+// the deposits before the block are no longer fetched, replay them if needed
+func SetCursor(r *pb.Repository, bank, typ string, number uint64) (prev pb.ConfirmedBlock, err error) {
+	t, err := blockType(typ)
+	if err != nil {
+		return
+	}
+	if bank, err = bankAddress(bank); err != nil {
+		return
+	}
+	if prev, err = r.GetConfirmedBlock(bank, t); err != nil {
+		return
+	}
+
+	now := time.Now().UTC()
+	if err = r.PutConfirmedBlock(bank, t, pb.ConfirmedBlock{Number: number, UpdatedAt: &now}); err != nil {
+		return
+	}
+	_, err = audit.Append(r, audit.ActorOperator, audit.ActionCursorSet, typ, audit.Detail("bank", bank), audit.Detail("from", prev.Number), audit.Detail("to", number))
+	return
+}
+
+// Replay re-fetches the deposits of the type logged by the banks in the range, including the both ends.
+// the succeeded ones are skipped and the rest are minted, the cursors are left as is
+func (b *Bridge) Replay(ctx context.Context, typ string, from, to uint64) error {
+	if _, err := blockType(typ); err != nil {
+		return err
+	}
+	if from > to {
+		return fmt.Errorf("%w, from(%d) is after to(%d)", ErrInvalidRange, from, to)
+	}
+	if b.MintingPaused() {
+		return ErrLowFunds
+	}
+
+	latest, err := b.reaadClient.LatestBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if to > latest {
+		return fmt.Errorf("%w, to(%d) is after the latest block(%d)", ErrInvalidRange, to, latest)
+	}
+
+	for _, bk := range b.Banks {
+		if _, err = audit.Append(b.Repo, audit.ActorOperator, audit.ActionBlocksReplayed, typ, audit.Detail("bank", bk.Address), audit.Detail("from", from), audit.Detail("to", to)); err != nil {
+			return err
+		}
+
+		b.logger.Info().Msgf("replaying %s logs of bank(%s), from: %d, to: %d", typ, bk.Address, from, to)
+		if err = b.filterLogs(ctx, bk, typ, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bankAddress(bank string) (string, error) {
+	if !common.IsHexAddress(bank) {
+		return "", fmt.Errorf("%w, bank: %s", ErrInvalidAddress, bank)
+	}
+	return common.HexToAddress(bank).Hex(), nil
+}
+
+func blockType(typ string) (pb.BlockType, error) {
+	switch typ {
+	case pb.EventTypeERC20:
+		return pb.BlockERC20, nil
+	case pb.EventTypeNFT:
+		return pb.BlockNFT, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrUnknownEventType, typ)
+	}
+}
@@ -0,0 +1,68 @@
+package bridge
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// dryRun keeps the mint txs built but never broadcast
+type dryRun struct {
+	sync.Mutex
+
+	enabled bool
+	counts  map[string]int
+	fee     *big.Int
+}
+
+// DryRunSummary is the mints which would be sent
+type DryRunSummary struct {
+	Counts map[string]int
+	// the sum of the `gas * gas price`
+	Fee *big.Int
+}
+
+func (b *Bridge) DryRun() bool {
+	return b.dryRun.enabled
+}
+
+// DryRunSummary returns the mints reported so far
+func (b *Bridge) DryRunSummary() DryRunSummary {
+	b.dryRun.Lock()
+	defer b.dryRun.Unlock()
+
+	s := DryRunSummary{Counts: make(map[string]int, len(b.dryRun.counts)), Fee: new(big.Int)}
+	for typ, n := range b.dryRun.counts {
+		s.Counts[typ] = n
+	}
+	if b.dryRun.fee != nil {
+		s.Fee.Set(b.dryRun.fee)
+	}
+	return s
+}
+
+// reportDryRun logs the mint tx instead of broadcasting it
+func (b *Bridge) reportDryRun(e pb.Event, pair pb.Pair, tx *types.Transaction) {
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasPrice())
+
+	b.dryRun.Lock()
+	if b.dryRun.counts == nil {
+		b.dryRun.counts, b.dryRun.fee = make(map[string]int), new(big.Int)
+	}
+	b.dryRun.counts[e.Type()]++
+	b.dryRun.fee.Add(b.dryRun.fee, fee)
+	b.dryRun.Unlock()
+
+	b.logger.Info().
+		Str("event", eventKey(e)).
+		Str("token", e.GetToken()).
+		Str("out", pair.Outaddr).
+		Str("hash", tx.Hash().Hex()).
+		Uint64("nonce", tx.Nonce()).
+		Uint64("gas", tx.Gas()).
+		Str("gas_price", tx.GasPrice().String()).
+		Str("fee", fee.String()).
+		Msgf("dry run, the mint is not sent: %v", e)
+}
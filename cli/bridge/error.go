@@ -5,6 +5,21 @@ import (
 )
 
 var (
-	ErrEventNotFound = errors.New("event not found")
-	ErrPairNotFound  = errors.New("pair not found")
+	ErrEventNotFound    = errors.New("event not found")
+	ErrEventSucceeded   = errors.New("event already succeeded")
+	ErrEventInflight    = errors.New("event is in flight")
+	ErrUnknownEventType = errors.New("unknown event type")
+	ErrPairNotFound     = errors.New("pair not found")
+	ErrPairDisabled     = errors.New("pair is disabled")
+	ErrDuplicatedPair   = errors.New("duplicated pair")
+	ErrNotContract      = errors.New("no contract code at the address")
+	ErrNotWhitelisted   = errors.New("not whitelisted in the bridge contract")
+	ErrMintUnsupported  = errors.New("the out contract does not implement the mint")
+	ErrMintDenied       = errors.New("the relayer is not allowed to mint")
+	ErrNoPairChecker    = errors.New("the pair checks are not configured, skip them explicitly")
+	ErrInvalidAddress   = errors.New("invalid address format")
+	ErrLowFunds         = errors.New("minting is paused by the low balance of the relayer")
+	ErrInvalidRange     = errors.New("invalid block range")
+	ErrDuplicatedBank   = errors.New("duplicated bank")
+	ErrBankRequired     = errors.New("the bank is required, multiple banks are watched")
 )
@@ -0,0 +1,28 @@
+package bridge
+
+import (
+	"fmt"
+
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// ListEvents returns the stored events of the type, filtered by the status when it is not nil.
+// shared by the api and the grpc service
+func ListEvents(r *pb.Repository, typ string, status *pb.EventStatus) (events []pb.Event, err error) {
+	collect := func(e pb.Event) error {
+		if status == nil || e.GetStatus() == *status {
+			events = append(events, e)
+		}
+		return nil
+	}
+
+	switch typ {
+	case pb.EventTypeERC20:
+		err = r.IterateEventsERC20(func(e *pb.EventERC20Deposited) error { return collect(e) })
+	case pb.EventTypeNFT:
+		err = r.IterateEventsNFT(func(e *pb.EventNFTDeposited) error { return collect(e) })
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnknownEventType, typ)
+	}
+	return
+}
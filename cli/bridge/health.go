@@ -0,0 +1,130 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+var (
+	healthProbeKey = []byte(".healthprobe")
+
+	DefaultHealthThresholds = HealthThresholds{
+		MaxFetchStaleness: 5 * time.Minute,
+		MaxCursorLag:      1000,
+		MaxInflightAge:    10 * time.Minute,
+		MaxConfirmerQueue: 1024,
+	}
+)
+
+// HealthThresholds are the limits beyond which the bridge is reported as unhealthy
+type HealthThresholds struct {
+	// the max time since the last successful log fetch of each bank and asset
+	MaxFetchStaleness time.Duration
+	// the max blocks the confirmed block can lag behind the in chain head
+	MaxCursorLag uint64
+	// the max time an event can wait for the mint confirmation
+	MaxInflightAge time.Duration
+	// the max length of the confirmer queue
+	MaxConfirmerQueue int
+}
+
+type Check struct {
+	Name    string `json:"name"`
+	OK      bool   `json:"ok"`
+	Message string `json:"message,omitempty"`
+}
+
+func newCheck(name string, err error) Check {
+	if err != nil {
+		return Check{Name: name, OK: false, Message: err.Error()}
+	}
+	return Check{Name: name, OK: true}
+}
+
+// Liveness checks the pipeline of each bank and type is progressing, which is stuck when its in-flight events never drain
+func (b *Bridge) Liveness(th HealthThresholds) []Check {
+	b.Lock()
+	defer b.Unlock()
+
+	now := time.Now()
+	checks := make([]Check, 0, len(b.lastFetchedAt))
+	for _, bk := range b.Banks {
+		for _, typ := range []string{pb.EventTypeERC20, pb.EventTypeNFT} {
+			var err error
+			if elapsed := now.Sub(b.lastFetchedAt[pipelineKey{bank: bk.Address, typ: typ}]); elapsed > th.MaxFetchStaleness {
+				err = fmt.Errorf("no successful fetch for %s, limit: %s", elapsed.Truncate(time.Second), th.MaxFetchStaleness)
+			}
+			checks = append(checks, newCheck(fmt.Sprintf("fetch-%s-%s", typ, bk.Address), err))
+		}
+	}
+
+	return checks
+}
+
+// Readiness checks the dependencies of the bridge and the freshness of the cursors
+func (b *Bridge) Readiness(ctx context.Context, th HealthThresholds) (checks []Check) {
+	head, err := b.reaadClient.LatestBlockNumber(ctx)
+	checks = append(checks, newCheck("rpc-in", err))
+
+	_, err = b.client.LatestBlockNumber(ctx)
+	checks = append(checks, newCheck("rpc-out", err))
+
+	checks = append(checks, newCheck("db", b.probeDB()))
+
+	b.Lock()
+	// the bank furthest behind
+	erc20, nft := b.lowestCursors()
+	cursors := map[string]uint64{
+		pb.EventTypeERC20: erc20,
+		pb.EventTypeNFT:   nft,
+	}
+	var oldest time.Time
+	for _, t := range b.detectedAt {
+		if oldest.IsZero() || t.Before(oldest) {
+			oldest = t
+		}
+	}
+	b.Unlock()
+
+	for _, typ := range []string{pb.EventTypeERC20, pb.EventTypeNFT} {
+		var err error
+		if head > 0 && lag(head, cursors[typ]) > th.MaxCursorLag {
+			err = fmt.Errorf("confirmed block %d lags %d blocks behind head %d, limit: %d", cursors[typ], lag(head, cursors[typ]), head, th.MaxCursorLag)
+		}
+		checks = append(checks, newCheck("cursor-"+typ, err))
+	}
+
+	err = nil
+	if queued := b.confirmer.QueueLen(); queued > th.MaxConfirmerQueue {
+		err = fmt.Errorf("%d txs are queued, limit: %d", queued, th.MaxConfirmerQueue)
+	} else if !oldest.IsZero() && time.Since(oldest) > th.MaxInflightAge {
+		err = fmt.Errorf("the oldest in-flight event waits for %s, limit: %s", time.Since(oldest).Truncate(time.Second), th.MaxInflightAge)
+	}
+	checks = append(checks, newCheck("confirmer", err))
+
+	err = nil
+	if b.MintingPaused() {
+		err = ErrLowFunds
+	}
+	checks = append(checks, newCheck("funds", err))
+
+	return
+}
+
+func (b *Bridge) probeDB() error {
+	if err := b.Repo.DB.Put(healthProbeKey, []byte(strconv.FormatInt(time.Now().Unix(), 10))); err != nil {
+		return err
+	}
+	return b.Repo.DB.Delete(healthProbeKey)
+}
+
+func (b *Bridge) markFetched(bank, typ string) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.lastFetchedAt[pipelineKey{bank: bank, typ: typ}] = time.Now()
+}
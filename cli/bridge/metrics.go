@@ -0,0 +1,49 @@
+package bridge
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/tak1827/evm-bridge/cli/metrics"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// CollectMetrics refreshes the gauges which need the rpc calls, expected to be called periodically
+func (b *Bridge) CollectMetrics(ctx context.Context) error {
+	head, err := b.reaadClient.LatestBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	b.Lock()
+	for _, bk := range b.Banks {
+		erc20, nft := bk.ConfirmedBlockERC20.Number, bk.ConfirmedBlockNFT.Number
+		metrics.ConfirmedBlock.WithLabelValues(pb.EventTypeERC20, bk.Address).Set(float64(erc20))
+		metrics.ConfirmedBlock.WithLabelValues(pb.EventTypeNFT, bk.Address).Set(float64(nft))
+		metrics.CursorLag.WithLabelValues(pb.EventTypeERC20, bk.Address).Set(float64(lag(head, erc20)))
+		metrics.CursorLag.WithLabelValues(pb.EventTypeNFT, bk.Address).Set(float64(lag(head, nft)))
+	}
+	b.Unlock()
+
+	balance, err := b.client.BalanceAt(ctx, b.wallet.Address())
+	if err != nil {
+		return err
+	}
+	value, _ := new(big.Float).SetInt(balance).Float64()
+	metrics.SignerBalance.Set(value)
+
+	nonce, err := b.wallet.Nonce.Current()
+	if err != nil {
+		return err
+	}
+	metrics.SignerNonce.Set(float64(nonce))
+
+	return nil
+}
+
+func lag(head, confirmed uint64) uint64 {
+	if head < confirmed {
+		return 0
+	}
+	return head - confirmed
+}
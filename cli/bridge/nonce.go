@@ -0,0 +1,221 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+const (
+	NONCE_SYNC_TIMEOUT = 30 * time.Second
+)
+
+var (
+	ErrInvalidNonceSyncPolicy = errors.New("invalid nonce sync policy")
+
+	DefaultNonceSyncPolicy = NonceSyncPolicy{
+		Interval:     30 * time.Second,
+		StuckTimeout: 5 * time.Minute,
+		GasBump:      10,
+	}
+)
+
+// NonceSyncPolicy decides how the local nonce of the relayer is kept in sync with the chain
+type NonceSyncPolicy struct {
+	// the drift is checked every interval, 0 checks only on the "nonce-too-low" failure
+	Interval time.Duration
+	// the lowest pending tx is cancelled when not mined in the timeout, 0 never cancels
+	StuckTimeout time.Duration
+	// the gas price of the cancel tx is bumped by the percent for each attempt
+	GasBump uint64
+}
+
+func (p NonceSyncPolicy) Validate() error {
+	if p.Interval < 0 || p.StuckTimeout < 0 {
+		return fmt.Errorf("%w: negative duration", ErrInvalidNonceSyncPolicy)
+	}
+	// the nodes require at least 10% to replace the pending tx
+	if p.StuckTimeout > 0 && p.GasBump < 10 {
+		return fmt.Errorf("%w: gas bump(%d) must be at least 10 percent", ErrInvalidNonceSyncPolicy, p.GasBump)
+	}
+	return nil
+}
+
+// nonceTracker is held while the nonce is allocated and the tx is sent,
+// so that the sync never takes the allocated but unsent nonce as the gap
+type nonceTracker struct {
+	sync.Mutex
+
+	// the hashes of the sent mint txs by nonce, pruned once mined
+	hashes map[uint64]string
+
+	// the lowest pending nonce and since when it is not mined
+	stuckNonce uint64
+	stuckSince time.Time
+	cancels    uint64
+}
+
+func newNonceTracker() nonceTracker {
+	return nonceTracker{hashes: make(map[uint64]string)}
+}
+
+func (t *nonceTracker) prune(latest uint64) {
+	for n := range t.hashes {
+		if n < latest {
+			delete(t.hashes, n)
+		}
+	}
+}
+
+// runNonceSync checks the nonce drift every interval until the context is done
+func (b *Bridge) runNonceSync(ctx context.Context) {
+	ticker := time.NewTicker(b.nonceSync.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.resyncNonce(); err != nil {
+				b.logger.Warn().Msgf("failed to sync nonce, err: %v", err)
+			}
+		}
+	}
+}
+
+// resyncNonce syncs the nonce in the timeout
+func (b *Bridge) resyncNonce() error {
+	ctx, cancel := context.WithTimeout(context.Background(), NONCE_SYNC_TIMEOUT)
+	defer cancel()
+
+	return b.syncNonce(ctx, time.Now())
+}
+
+// syncNonce compares the local nonce with the chain.
+// the nonces taken by other senders are skipped, the gaps left by the dropped or unsent txs are filled,
+// and the tx stuck in the mem pool longer than the timeout is cancelled, all by the self transfers
+func (b *Bridge) syncNonce(ctx context.Context, now time.Time) error {
+	b.nonces.Lock()
+	defer b.nonces.Unlock()
+
+	addr := b.wallet.Address()
+
+	latest, err := b.client.NonceAt(ctx, addr)
+	if err != nil {
+		return err
+	}
+	pending, err := b.client.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return err
+	}
+	local, err := b.wallet.Nonce.Current()
+	if err != nil {
+		return err
+	}
+
+	b.nonces.prune(latest)
+
+	switch {
+	case local < pending:
+		b.logger.Warn().Msgf("nonce drifted, the relayer key is used elsewhere, local: %d, pending: %d", local, pending)
+		b.wallet.Nonce.Reset(pending)
+		b.audit(audit.ActionNonceReset, addr.Hex(), audit.Detail("from", local), audit.Detail("to", pending))
+	case local > pending:
+		b.logger.Warn().Msgf("nonce gap detected, local: %d, pending: %d", local, pending)
+		if pending, err = b.fillGap(ctx, addr, pending, local); err != nil {
+			return err
+		}
+	}
+
+	if pending <= latest || b.nonceSync.StuckTimeout == 0 {
+		b.nonces.stuckSince = time.Time{}
+		return nil
+	}
+
+	if b.nonces.stuckSince.IsZero() || b.nonces.stuckNonce != latest {
+		b.nonces.stuckNonce, b.nonces.stuckSince, b.nonces.cancels = latest, now, 0
+		return nil
+	}
+
+	if now.Sub(b.nonces.stuckSince) < b.nonceSync.StuckTimeout {
+		return nil
+	}
+
+	b.nonces.cancels++
+	b.nonces.stuckSince = now
+	gasPrice := bumpGasPrice(b.client.GasPrice, b.nonceSync.GasBump, b.nonces.cancels)
+	b.logger.Warn().Msgf("nonce(%d) is stuck over %s, cancelling with gas price: %s", latest, b.nonceSync.StuckTimeout, gasPrice)
+	return b.cancelNonce(ctx, latest, gasPrice)
+}
+
+// fillGap fills the nonces from the pending up to the local one, returns the pending nonce after filled.
+// the queued txs behind the gap become executable, so the pending nonce is re-read after each fill
+func (b *Bridge) fillGap(ctx context.Context, addr common.Address, pending, local uint64) (uint64, error) {
+	for n := pending; n < local; {
+		if err := b.cancelNonce(ctx, n, b.client.GasPrice); err != nil {
+			return pending, err
+		}
+
+		next, err := b.client.PendingNonceAt(ctx, addr)
+		if err != nil {
+			return pending, err
+		}
+		if pending = next; next <= n {
+			next = n + 1
+		}
+		n = next
+	}
+	return pending, nil
+}
+
+// cancelNonce sends the self transfer of the nonce, the mint tx of the nonce is dropped
+func (b *Bridge) cancelNonce(ctx context.Context, nonce uint64, gasPrice *big.Int) error {
+	tx, err := b.client.BuildCancelTx(b.wallet.priv, nonce, gasPrice)
+	if err != nil {
+		return err
+	}
+
+	hash, err := b.client.SendTx(ctx, tx)
+	if err != nil {
+		// the tx of the nonce is in the pool already, waits for it
+		if errors.Is(err, client.ErrUnderpriced) || errors.Is(err, client.ErrNonceTooLow) {
+			b.logger.Info().Msgf("nonce(%d) is not cancelled, err: %v", nonce, err)
+			return nil
+		}
+		return err
+	}
+
+	details := []pb.AuditDetail{audit.Detail("nonce", nonce), audit.Detail("hash", hash), audit.Detail("gas_price", gasPrice)}
+	if h, ok := b.nonces.hashes[nonce]; ok {
+		b.client.Drop(h)
+		delete(b.nonces.hashes, nonce)
+		details = append(details, audit.Detail("dropped", h))
+	}
+	b.audit(audit.ActionNonceCancelled, b.wallet.Address().Hex(), details...)
+
+	b.logger.Info().Msgf("cancelled nonce(%d), hash: %s", nonce, hash)
+	return nil
+}
+
+// bumpGasPrice returns the price bumped by the percent the times, at least 1 wei higher
+func bumpGasPrice(price *big.Int, percent, times uint64) *big.Int {
+	bumped := new(big.Int).Set(price)
+	for i := uint64(0); i < times; i++ {
+		next := new(big.Int).Mul(bumped, new(big.Int).SetUint64(100+percent))
+		next.Div(next, big.NewInt(100))
+		if next.Cmp(bumped) <= 0 {
+			next.Add(bumped, big.NewInt(1))
+		}
+		bumped = next
+	}
+	return bumped
+}
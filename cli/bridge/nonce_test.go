@@ -0,0 +1,93 @@
+package bridge
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/simulated"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+func TestBumpGasPrice(t *testing.T) {
+	require.Equal(t, big.NewInt(100), bumpGasPrice(big.NewInt(100), 10, 0))
+	require.Equal(t, big.NewInt(110), bumpGasPrice(big.NewInt(100), 10, 1))
+	require.Equal(t, big.NewInt(121), bumpGasPrice(big.NewInt(100), 10, 2))
+	// the zero gas price chain
+	require.Equal(t, big.NewInt(2), bumpGasPrice(big.NewInt(0), 10, 2))
+}
+
+func TestNonceSyncPolicy(t *testing.T) {
+	require.NoError(t, DefaultNonceSyncPolicy.Validate())
+	require.NoError(t, NonceSyncPolicy{}.Validate())
+	require.ErrorIs(t, NonceSyncPolicy{StuckTimeout: time.Minute, GasBump: 5}.Validate(), ErrInvalidNonceSyncPolicy)
+	require.ErrorIs(t, NonceSyncPolicy{Interval: -1}.Validate(), ErrInvalidNonceSyncPolicy)
+
+	tr := newNonceTracker()
+	tr.hashes[1], tr.hashes[2], tr.hashes[3] = "0x1", "0x2", "0x3"
+	tr.prune(3)
+	require.Equal(t, map[uint64]string{3: "0x3"}, tr.hashes)
+}
+
+func TestSyncNonce(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := simulated.NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, QueueSize)
+	b, err := NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), WithNonceSyncPolicy(NonceSyncPolicy{StuckTimeout: time.Minute, GasBump: 10}))
+	require.NoError(t, err)
+
+	var (
+		relayer = b.wallet.Address()
+		now     = time.Now()
+	)
+	nonceAt := func() uint64 {
+		nonce, err := c.NonceAt(ctx, relayer)
+		require.NoError(t, err)
+		return nonce
+	}
+
+	// the local nonce ahead of the chain, the gap left by the unsent txs is filled by the self transfers
+	b.wallet.Nonce.Reset(3)
+	require.NoError(t, b.syncNonce(ctx, now))
+	require.Equal(t, uint64(3), nonceAt())
+
+	// the nonce taken by the other sender is skipped
+	h.Hold(true)
+	stuck, err := c.BuildCancelTx(h.Relayer, 3, c.GasPrice)
+	require.NoError(t, err)
+	_, err = c.SendTx(ctx, stuck)
+	require.NoError(t, err)
+	require.NoError(t, b.syncNonce(ctx, now))
+	local, err := b.wallet.Nonce.Current()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), local)
+
+	// the stuck tx is replaced by the self transfer with the higher gas price after the timeout
+	b.nonces.hashes[3] = stuck.Hash().Hex()
+	require.NoError(t, b.syncNonce(ctx, now.Add(30*time.Second)))
+	require.Contains(t, b.nonces.hashes, uint64(3))
+	require.NoError(t, b.syncNonce(ctx, now.Add(2*time.Minute)))
+	require.NotContains(t, b.nonces.hashes, uint64(3))
+
+	h.Mine(1)
+	require.Equal(t, uint64(4), nonceAt())
+	block, err := h.BlockByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, block.Transactions(), 1)
+	require.NotEqual(t, stuck.Hash(), block.Transactions()[0].Hash())
+	require.Equal(t, bumpGasPrice(c.GasPrice, 10, 1), block.Transactions()[0].GasPrice())
+
+	// the mint tx of the nonce is reported as dropped
+	require.ErrorIs(t, c.ConfirmTx(ctx, stuck.Hash().Hex(), 1), client.ErrTxDropped)
+}
@@ -0,0 +1,92 @@
+package bridge
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+type Stage string
+
+const (
+	StageDetected  Stage = "detected"
+	StageSent      Stage = "sent"
+	StageRetried   Stage = "retried" // scheduled at the next attempt time of the event
+	StageSucceeded Stage = "succeeded"
+	StageFailed    Stage = "failed"
+	StagePaused    Stage = "paused"
+)
+
+// Notification is published on every lifecycle change of the events
+type Notification struct {
+	Stage     Stage
+	Hash      string
+	Event     pb.Event
+	Err       error
+	CreatedAt time.Time
+}
+
+// Listener is called synchronously by the bridge, should not block
+type Listener func(n Notification)
+
+type notifier struct {
+	sync.RWMutex
+
+	listeners map[int]Listener
+	nextID    int
+}
+
+// Subscribe registers the listener, the returned function unregisters it
+func (b *Bridge) Subscribe(l Listener) (unsubscribe func()) {
+	b.notifier.Lock()
+	defer b.notifier.Unlock()
+
+	if b.notifier.listeners == nil {
+		b.notifier.listeners = make(map[int]Listener)
+	}
+
+	id := b.notifier.nextID
+	b.notifier.nextID++
+	b.notifier.listeners[id] = l
+
+	return func() {
+		b.notifier.Lock()
+		defer b.notifier.Unlock()
+
+		delete(b.notifier.listeners, id)
+	}
+}
+
+func (b *Bridge) publish(stage Stage, hash string, e pb.Event, err error) {
+	b.notifier.RLock()
+	defer b.notifier.RUnlock()
+
+	if len(b.notifier.listeners) == 0 {
+		return
+	}
+
+	n := Notification{
+		Stage:     stage,
+		Hash:      hash,
+		Event:     clone(e),
+		Err:       err,
+		CreatedAt: time.Now(),
+	}
+	for _, l := range b.notifier.listeners {
+		l(n)
+	}
+}
+
+// clone copies the event so that listeners never see the later changes
+func clone(e pb.Event) pb.Event {
+	switch v := e.(type) {
+	case *pb.EventERC20Deposited:
+		c := *v
+		return &c
+	case *pb.EventNFTDeposited:
+		c := *v
+		return &c
+	}
+	return e
+}
@@ -1,6 +1,8 @@
 package bridge
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/client"
 	"github.com/tak1827/transaction-confirmer/confirm"
 )
 
@@ -27,3 +29,93 @@ func (f CustomErrHandler) Apply(b *Bridge) error {
 func WithCustomErrHandler(f confirm.ErrHandler) CustomErrHandler {
 	return CustomErrHandler(f)
 }
+
+type RetryPolicyOpt struct {
+	typ    string
+	policy RetryPolicy
+}
+
+func (o RetryPolicyOpt) Apply(b *Bridge) error {
+	if err := o.policy.Validate(); err != nil {
+		return err
+	}
+	b.retryPolicies[o.typ] = o.policy
+	return nil
+}
+func WithRetryPolicy(typ string, policy RetryPolicy) RetryPolicyOpt {
+	return RetryPolicyOpt{typ: typ, policy: policy}
+}
+
+type NonceSyncPolicyOpt NonceSyncPolicy
+
+func (o NonceSyncPolicyOpt) Apply(b *Bridge) error {
+	if err := NonceSyncPolicy(o).Validate(); err != nil {
+		return err
+	}
+	b.nonceSync = NonceSyncPolicy(o)
+	return nil
+}
+func WithNonceSyncPolicy(policy NonceSyncPolicy) NonceSyncPolicyOpt {
+	return NonceSyncPolicyOpt(policy)
+}
+
+type BalancePolicyOpt BalancePolicy
+
+func (o BalancePolicyOpt) Apply(b *Bridge) error {
+	if err := BalancePolicy(o).Validate(); err != nil {
+		return err
+	}
+	b.balancePolicy = BalancePolicy(o)
+	return nil
+}
+func WithBalancePolicy(policy BalancePolicy) BalancePolicyOpt {
+	return BalancePolicyOpt(policy)
+}
+
+type DryRunOpt bool
+
+func (o DryRunOpt) Apply(b *Bridge) error {
+	b.dryRun.enabled = bool(o)
+	return nil
+}
+
+// WithDryRun builds and estimates the mint txs without sending them
+func WithDryRun(enabled bool) DryRunOpt {
+	return DryRunOpt(enabled)
+}
+
+type BanksOpt []*client.ReadClient
+
+func (o BanksOpt) Apply(b *Bridge) error {
+	return b.addBanks(o...)
+}
+
+// WithBanks watches the banks in addition to the one of the read client, each has its own cursors
+func WithBanks(rcs ...*client.ReadClient) BanksOpt {
+	return BanksOpt(rcs)
+}
+
+type BridgeContractOpt common.Address
+
+func (o BridgeContractOpt) Apply(b *Bridge) error {
+	addr := common.Address(o)
+	b.bridgeContract = &addr
+	return nil
+}
+
+// WithBridgeContract checks the pairs set by the apis against the whitelist of the bridge contract
+func WithBridgeContract(addr common.Address) BridgeContractOpt {
+	return BridgeContractOpt(addr)
+}
+
+type ConfirmationBlocksOpt uint64
+
+func (o ConfirmationBlocksOpt) Apply(b *Bridge) error {
+	b.untracked.blocks = uint64(o)
+	return nil
+}
+
+// WithConfirmationBlocks confirms the txs rejected by the confirmer queue as the confirmer does
+func WithConfirmationBlocks(blocks uint64) ConfirmationBlocksOpt {
+	return ConfirmationBlocksOpt(blocks)
+}
@@ -0,0 +1,162 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/go-store/store"
+)
+
+// PairSpec is the pair entry of the import file, either toml or json
+type PairSpec struct {
+	Inaddr   string `json:"inaddr" mapstructure:"inaddr"`
+	Outaddr  string `json:"outaddr" mapstructure:"outaddr"`
+	Wrapped  bool   `json:"wrapped" mapstructure:"wrapped"`
+	Disabled bool   `json:"disabled" mapstructure:"disabled"`
+	// the rewrite rule of the nft token uri, the prefix `URIFrom` is replaced by `URITo`
+	URIFrom string `json:"uri_from" mapstructure:"uri_from"`
+	URITo   string `json:"uri_to" mapstructure:"uri_to"`
+}
+
+// SetPair validates and registers the in and out chain contract address pair, after checked against the chains.
+// shared by the `pair set` command and the admin apis. the disabled pair is kept disabled
+func SetPair(ctx context.Context, r *pb.Repository, spec PairSpec, checker *PairChecker) (pair pb.Pair, err error) {
+	if pair, err = newPair(spec); err != nil {
+		return
+	}
+	if err = checker.checkSpec(ctx, spec); err != nil {
+		return
+	}
+
+	current, err := r.GetPair(pair.Inaddr)
+	switch {
+	case err == nil:
+		pair.Disabled = current.Disabled
+	case !errors.Is(err, store.ErrNotFound):
+		return
+	}
+
+	if err = putPair(r, &pair); err != nil {
+		return
+	}
+	_, err = audit.Append(r, audit.ActorOperator, audit.ActionPairSet, pair.Inaddr, pairDetails(pair)...)
+	return
+}
+
+// GetPair returns the pair registered by the in chain contract address
+func GetPair(r *pb.Repository, inAddr string) (pair pb.Pair, err error) {
+	if !common.IsHexAddress(inAddr) {
+		err = fmt.Errorf("%w, in-addr: %s", ErrInvalidAddress, inAddr)
+		return
+	}
+
+	if pair, err = r.GetPair(common.HexToAddress(inAddr).Hex()); errors.Is(err, store.ErrNotFound) {
+		err = fmt.Errorf("%w, in-addr: %s", ErrPairNotFound, inAddr)
+	}
+	return
+}
+
+// DeletePair unregisters the pair, the events of the token are no longer bridged
+func DeletePair(r *pb.Repository, inAddr string) error {
+	pair, err := GetPair(r, inAddr)
+	if err != nil {
+		return err
+	}
+	if err = r.DeletePair(pair.Inaddr); err != nil {
+		return err
+	}
+	_, err = audit.Append(r, audit.ActorOperator, audit.ActionPairDeleted, pair.Inaddr, pairDetails(pair)...)
+	return err
+}
+
+// SetPairDisabled disables or enables the pair keeping its config.
+// the events detected while disabled are paused, and can be retried after enabled
+func SetPairDisabled(r *pb.Repository, inAddr string, disabled bool) (pair pb.Pair, err error) {
+	if pair, err = GetPair(r, inAddr); err != nil {
+		return
+	}
+
+	pair.Disabled = disabled
+	if err = putPair(r, &pair); err != nil {
+		return
+	}
+
+	action := audit.ActionPairEnabled
+	if disabled {
+		action = audit.ActionPairDisabled
+	}
+	_, err = audit.Append(r, audit.ActorOperator, action, pair.Inaddr, pairDetails(pair)...)
+	return
+}
+
+// ImportPairs registers the pairs at once, nothing is written when any of them is invalid or fails the checks
+func ImportPairs(ctx context.Context, r *pb.Repository, specs []PairSpec, checker *PairChecker) (pairs []pb.Pair, err error) {
+	seen := make(map[string]bool, len(specs))
+	for i := range specs {
+		var pair pb.Pair
+		if pair, err = newPair(specs[i]); err != nil {
+			err = fmt.Errorf("pair %d: %w", i, err)
+			return
+		}
+		if seen[pair.Inaddr] {
+			err = fmt.Errorf("pair %d: %w, in-addr: %s", i, ErrDuplicatedPair, pair.Inaddr)
+			return
+		}
+		seen[pair.Inaddr] = true
+		pairs = append(pairs, pair)
+	}
+
+	for i := range specs {
+		if err = checker.checkSpec(ctx, specs[i]); err != nil {
+			err = fmt.Errorf("pair %d: %w", i, err)
+			return
+		}
+	}
+
+	for i := range pairs {
+		if err = putPair(r, &pairs[i]); err != nil {
+			return
+		}
+		if _, err = audit.Append(r, audit.ActorOperator, audit.ActionPairSet, pairs[i].Inaddr, pairDetails(pairs[i])...); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func newPair(spec PairSpec) (pair pb.Pair, err error) {
+	if !common.IsHexAddress(spec.Inaddr) {
+		err = fmt.Errorf("%w, in-addr: %s", ErrInvalidAddress, spec.Inaddr)
+		return
+	}
+	if !common.IsHexAddress(spec.Outaddr) {
+		err = fmt.Errorf("%w, out-addr: %s", ErrInvalidAddress, spec.Outaddr)
+		return
+	}
+
+	pair = pb.Pair{
+		Inaddr:   common.HexToAddress(spec.Inaddr).Hex(),
+		Outaddr:  common.HexToAddress(spec.Outaddr).Hex(),
+		Intype:   pb.Pair_ORIGINAL,
+		Disabled: spec.Disabled,
+		UriFrom:  spec.URIFrom,
+		UriTo:    spec.URITo,
+	}
+
+	if spec.Wrapped {
+		pair.Intype = pb.Pair_WRAPPED
+	}
+	return
+}
+
+// putPair stamps the time of the change
+func putPair(r *pb.Repository, pair *pb.Pair) error {
+	now := time.Now().UTC()
+	pair.UpdatedAt = &now
+	return r.PutPair(pair)
+}
@@ -0,0 +1,61 @@
+package bridge
+
+import (
+	"sync"
+
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// pairCache holds all pairs in memory, so that the events are sent without reading the db.
+// reloaded when the pair revision is changed by the cli, the api or the grpc
+type pairCache struct {
+	sync.RWMutex
+
+	revision uint64
+	loaded   bool
+	pairs    map[string]pb.Pair
+}
+
+// syncPairs reloads the pairs when changed since the last load. called once per fetch,
+// instead of per event, so that checking the revision costs a single read
+func (b *Bridge) syncPairs() error {
+	rev, err := b.Repo.PairRevision()
+	if err != nil {
+		return err
+	}
+
+	b.pairs.RLock()
+	fresh := b.pairs.loaded && b.pairs.revision == rev
+	b.pairs.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	// the revision is read before the pairs, so that the change in between is caught by the next sync
+	list, err := b.Repo.ListPairs()
+	if err != nil {
+		return err
+	}
+
+	pairs := make(map[string]pb.Pair, len(list))
+	for _, p := range list {
+		pairs[p.Inaddr] = p
+	}
+
+	b.pairs.Lock()
+	b.pairs.revision = rev
+	b.pairs.loaded = true
+	b.pairs.pairs = pairs
+	b.pairs.Unlock()
+
+	b.logger.Info().Msgf("loaded %d pairs, revision: %d", len(pairs), rev)
+	return nil
+}
+
+func (b *Bridge) getPair(inaddr string) (pair pb.Pair, ok bool) {
+	b.pairs.RLock()
+	defer b.pairs.RUnlock()
+
+	pair, ok = b.pairs.pairs[inaddr]
+	return
+}
@@ -0,0 +1,116 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// SkipPairChecks registers the pairs without the checks, as `--skip-checks`
+var SkipPairChecks = &PairChecker{skip: true}
+
+// PairChecker validates the pair against the chains before registering,
+// so that the wrong addresses are rejected instead of failing at the mint time
+type PairChecker struct {
+	in      *client.ReadClient
+	out     *client.Client
+	bridge  common.Address
+	relayer common.Address
+	skip    bool
+}
+
+func NewPairChecker(in *client.ReadClient, out *client.Client, bridgeAddr, relayer common.Address) *PairChecker {
+	return &PairChecker{
+		in:      in,
+		out:     out,
+		bridge:  bridgeAddr,
+		relayer: relayer,
+	}
+}
+
+// PairChecker returns the checker of the pairs set while serving, nil without the bridge contract
+func (b *Bridge) PairChecker() *PairChecker {
+	if b.bridgeContract == nil {
+		return nil
+	}
+	return NewPairChecker(b.reaadClient, b.client, *b.bridgeContract, b.wallet.Address())
+}
+
+// checkSpec checks the pair of the spec, the nil checker is rejected, so that the checks are skipped only explicitly
+func (p *PairChecker) checkSpec(ctx context.Context, spec PairSpec) error {
+	if p == nil {
+		return ErrNoPairChecker
+	}
+	if p.skip {
+		return nil
+	}
+	_, err := p.Check(ctx, spec.Inaddr, spec.Outaddr)
+	return err
+}
+
+// Check returns the event type of the token, after confirming that
+// both addresses are contracts, the in address is whitelisted in the bridge contract,
+// and the relayer can mint on the out contract
+func (p *PairChecker) Check(ctx context.Context, inAddr, outAddr string) (typ string, err error) {
+	if !common.IsHexAddress(inAddr) {
+		err = fmt.Errorf("%w, in-addr: %s", ErrInvalidAddress, inAddr)
+		return
+	}
+	if !common.IsHexAddress(outAddr) {
+		err = fmt.Errorf("%w, out-addr: %s", ErrInvalidAddress, outAddr)
+		return
+	}
+
+	var (
+		in  = common.HexToAddress(inAddr)
+		out = common.HexToAddress(outAddr)
+	)
+
+	code, err := p.in.CodeAt(ctx, in)
+	if err != nil {
+		return
+	}
+	if len(code) == 0 {
+		err = fmt.Errorf("%w, in-addr: %s", ErrNotContract, in.Hex())
+		return
+	}
+
+	if code, err = p.out.CodeAt(ctx, out); err != nil {
+		return
+	}
+	if len(code) == 0 {
+		err = fmt.Errorf("%w, out-addr: %s", ErrNotContract, out.Hex())
+		return
+	}
+
+	erc20, nft, err := p.in.Whitelisted(ctx, p.bridge, in)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case erc20:
+		typ = pb.EventTypeERC20
+		if err = p.out.CallERC20Mint(ctx, p.relayer, out); err != nil && !p.out.HasERC20Mint(code) {
+			err = fmt.Errorf("%w, `mint` of out-addr: %s, err: %s", ErrMintUnsupported, out.Hex(), err.Error())
+			return
+		}
+	case nft:
+		typ = pb.EventTypeNFT
+		if err = p.out.CallNFTMint(ctx, p.relayer, out); err != nil && !p.out.HasNFTMint(code) {
+			err = fmt.Errorf("%w, `safeMint` of out-addr: %s, err: %s", ErrMintUnsupported, out.Hex(), err.Error())
+			return
+		}
+	default:
+		err = fmt.Errorf("%w, in-addr: %s, bridge: %s", ErrNotWhitelisted, in.Hex(), p.bridge.Hex())
+		return
+	}
+
+	if err != nil {
+		err = fmt.Errorf("%w, relayer: %s, out-addr: %s, err: %s", ErrMintDenied, p.relayer.Hex(), out.Hex(), err.Error())
+	}
+	return
+}
@@ -0,0 +1,91 @@
+package bridge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+func TestPairLifecycle(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		repo = pb.NewRepository(db.NewMemDB())
+		in   = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+		out  = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	)
+
+	_, err := ImportPairs(ctx, repo, []PairSpec{{Inaddr: in, Outaddr: out}}, nil)
+	require.ErrorIs(t, err, ErrNoPairChecker)
+
+	_, err = ImportPairs(ctx, repo, []PairSpec{{Inaddr: in, Outaddr: out}, {Inaddr: in, Outaddr: out}}, SkipPairChecks)
+	require.ErrorIs(t, err, ErrDuplicatedPair)
+	pairs, err := repo.ListPairs()
+	require.NoError(t, err)
+	require.Len(t, pairs, 0)
+
+	pairs, err = ImportPairs(ctx, repo, []PairSpec{{Inaddr: in, Outaddr: out, Wrapped: true}}, SkipPairChecks)
+	require.NoError(t, err)
+	require.Equal(t, pb.Pair_WRAPPED, pairs[0].Intype)
+	require.NotNil(t, pairs[0].UpdatedAt)
+
+	pair, err := SetPairDisabled(repo, in, true)
+	require.NoError(t, err)
+	require.True(t, pair.Disabled)
+
+	// re-registering keeps the pair disabled
+	_, err = SetPair(ctx, repo, PairSpec{Inaddr: in, Outaddr: out, URIFrom: "ipfs://", URITo: "https://ipfs.io/ipfs/"}, SkipPairChecks)
+	require.NoError(t, err)
+	pair, err = GetPair(repo, in)
+	require.NoError(t, err)
+	require.True(t, pair.Disabled)
+	require.Equal(t, pb.Pair_ORIGINAL, pair.Intype)
+
+	// only the uri with the prefix is rewritten
+	require.Equal(t, "https://ipfs.io/ipfs/Qm1/1.json", pair.RewriteURI("ipfs://Qm1/1.json"))
+	require.Equal(t, "https://example.com/1.json", pair.RewriteURI("https://example.com/1.json"))
+	require.Equal(t, "", (&pb.Pair{}).RewriteURI(""))
+
+	require.NoError(t, DeletePair(repo, in))
+	_, err = GetPair(repo, in)
+	require.ErrorIs(t, err, ErrPairNotFound)
+	require.ErrorIs(t, DeletePair(repo, in), ErrPairNotFound)
+}
+
+func TestPairCache(t *testing.T) {
+	var (
+		s   = db.NewMemDB()
+		b   = &Bridge{Repo: pb.NewRepository(s), logger: log.Bridge("")}
+		cli = pb.NewRepository(s) // the other writer sharing the db, like the cli
+		in  = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+		out = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	)
+
+	require.NoError(t, b.syncPairs())
+	_, ok := b.getPair(in)
+	require.False(t, ok)
+
+	_, err := SetPair(context.Background(), cli, PairSpec{Inaddr: in, Outaddr: out}, SkipPairChecks)
+	require.NoError(t, err)
+	_, ok = b.getPair(in)
+	require.False(t, ok, "not synced yet")
+
+	require.NoError(t, b.syncPairs())
+	pair, ok := b.getPair(in)
+	require.True(t, ok)
+	require.False(t, pair.Disabled)
+
+	_, err = SetPairDisabled(cli, in, true)
+	require.NoError(t, err)
+	require.NoError(t, b.syncPairs())
+	pair, _ = b.getPair(in)
+	require.True(t, pair.Disabled)
+
+	require.NoError(t, DeletePair(cli, in))
+	require.NoError(t, b.syncPairs())
+	_, ok = b.getPair(in)
+	require.False(t, ok)
+}
@@ -0,0 +1,177 @@
+package bridge
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+// pipeline fetches the events of the type from the bank and mints them, independently of the others.
+// the next fetch waits only for the in-flight txs of its own bank and type, so the slow one never blocks the rest
+type pipeline struct {
+	bank  string
+	typ   string
+	block pb.BlockType
+	fetch func(ctx context.Context) (uint64, error)
+	// the confirmed block, the cursor of the type of the bank
+	cursor *pb.ConfirmedBlock
+	// signaled when the in-flight txs of the bank and the type are drained
+	idle chan struct{}
+}
+
+// pipelineKey identifies the pipeline by the bank and the type
+type pipelineKey struct {
+	bank string
+	typ  string
+}
+
+// pipelines runs the goroutines, the idle channels are listed by the bank and the type
+type pipelines struct {
+	wg   sync.WaitGroup
+	idle map[pipelineKey][]chan struct{}
+}
+
+func newPipelines() pipelines {
+	return pipelines{
+		idle: make(map[pipelineKey][]chan struct{}),
+	}
+}
+
+// Run starts the pipeline of each type of each bank, fetching every interval as long as no tx of the bank and the type is in flight.
+// the pipelines stop when done is closed, the fetch in progress is completed with the context, then `Wait` returns
+func (b *Bridge) Run(ctx context.Context, done <-chan struct{}, interval time.Duration) {
+	for _, bk := range b.Banks {
+		bk := bk
+		b.startPipeline(ctx, done, pipeline{
+			bank:   bk.Address,
+			typ:    pb.EventTypeERC20,
+			block:  pb.BlockERC20,
+			fetch:  func(ctx context.Context) (uint64, error) { return b.FetchERC20(ctx, bk) },
+			cursor: &bk.ConfirmedBlockERC20,
+		}, interval)
+		b.startPipeline(ctx, done, pipeline{
+			bank:   bk.Address,
+			typ:    pb.EventTypeNFT,
+			block:  pb.BlockNFT,
+			fetch:  func(ctx context.Context) (uint64, error) { return b.FetchNFT(ctx, bk) },
+			cursor: &bk.ConfirmedBlockNFT,
+		}, interval)
+	}
+}
+
+func (b *Bridge) startPipeline(ctx context.Context, done <-chan struct{}, p pipeline, interval time.Duration) {
+	p.idle = make(chan struct{}, 1)
+
+	b.Lock()
+	key := pipelineKey{bank: p.bank, typ: p.typ}
+	b.pipelines.idle[key] = append(b.pipelines.idle[key], p.idle)
+	b.Unlock()
+
+	b.pipelines.wg.Add(1)
+	go b.runPipeline(ctx, done, p, interval)
+}
+
+// Wait blocks until the pipelines stop, so that the cursors are not written after
+func (b *Bridge) Wait() {
+	b.pipelines.wg.Wait()
+}
+
+func (b *Bridge) runPipeline(ctx context.Context, done <-chan struct{}, p pipeline, interval time.Duration) {
+	defer b.pipelines.wg.Done()
+
+	logger := b.logger.With().Str("pipeline", p.typ).Str("bank", p.bank).Logger()
+	logger.Info().Msgf("pipeline is started, interval: %s", interval)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-done:
+			logger.Info().Msg("pipeline is stopped")
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if !b.waitIdle(done, p) {
+			continue
+		}
+		timer.Reset(interval)
+
+		// the cursors are kept until topped up. the paused loop is still alive, the funds are reported by the readiness
+		if b.MintingPaused() {
+			b.markFetched(p.bank, p.typ)
+			continue
+		}
+
+		// the events of the last fetch are all confirmed, so the cursor is committed
+		if err := b.Repo.PutConfirmedBlock(p.bank, p.block, *p.cursor); err != nil {
+			logger.Error().Msgf("failed to put confirmed block(%d), err: %v", p.cursor.Number, err)
+			continue
+		}
+
+		// the cursor is kept on failure, the same range is fetched again
+		end, err := p.fetch(ctx)
+		if err != nil {
+			logger.Warn().Err(err).Msgf("failed to fetch %s logs, class: %s", p.typ, Classify(err))
+			continue
+		}
+
+		b.Lock()
+		p.cursor.Number = end
+		b.Unlock()
+	}
+}
+
+// waitIdle blocks until no tx of the bank and the type is in flight, false when done
+func (b *Bridge) waitIdle(done <-chan struct{}, p pipeline) bool {
+	for {
+		if b.inflight(p.bank, p.typ) == 0 {
+			return true
+		}
+		select {
+		case <-done:
+			return false
+		case <-p.idle:
+		}
+	}
+}
+
+func (b *Bridge) inflight(bank, typ string) int {
+	b.Lock()
+	defer b.Unlock()
+
+	return b.countInflight(bank, typ)
+}
+
+// countInflight counts the in-flight txs of the bank and the type, should be called holding the lock
+func (b *Bridge) countInflight(bank, typ string) (n int) {
+	if typ == pb.EventTypeERC20 {
+		for _, e := range b.EventMapERC20 {
+			if e.Bank == bank {
+				n++
+			}
+		}
+		return
+	}
+	for _, e := range b.EventMapNFT {
+		if e.Bank == bank {
+			n++
+		}
+	}
+	return
+}
+
+// signalIdle wakes the pipelines of the bank and the type, should be called holding the lock
+func (b *Bridge) signalIdle(bank, typ string) {
+	for _, idle := range b.pipelines.idle[pipelineKey{bank: bank, typ: typ}] {
+		select {
+		case idle <- struct{}{}:
+		default:
+		}
+	}
+}
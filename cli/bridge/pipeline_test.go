@@ -0,0 +1,61 @@
+package bridge
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+func TestPipeline(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		done = make(chan struct{})
+		b    = &Bridge{
+			Repo:          pb.NewRepository(db.NewMemDB()),
+			logger:        log.Bridge(""),
+			EventMapERC20: map[string]*pb.EventERC20Deposited{"0xaa": {Id: "1", Bank: "0x1111111111111111111111111111111111111111"}},
+			EventMapNFT:   make(map[string]*pb.EventNFTDeposited),
+			pipelines:     newPipelines(),
+			Banks:         []*Bank{{Address: "0x1111111111111111111111111111111111111111"}, {Address: "0x2222222222222222222222222222222222222222"}},
+		}
+		fetchedERC20, fetchedNFT, fetchedOther int32
+	)
+
+	fetcher := func(counter *int32) func(context.Context) (uint64, error) {
+		return func(context.Context) (uint64, error) {
+			return uint64(atomic.AddInt32(counter, 1)) * 10, nil
+		}
+	}
+	bank, other := b.Banks[0], b.Banks[1]
+	for _, p := range []pipeline{
+		{bank: bank.Address, typ: pb.EventTypeERC20, block: pb.BlockERC20, fetch: fetcher(&fetchedERC20), cursor: &bank.ConfirmedBlockERC20},
+		{bank: bank.Address, typ: pb.EventTypeNFT, block: pb.BlockNFT, fetch: fetcher(&fetchedNFT), cursor: &bank.ConfirmedBlockNFT},
+		{bank: other.Address, typ: pb.EventTypeERC20, block: pb.BlockERC20, fetch: fetcher(&fetchedOther), cursor: &other.ConfirmedBlockERC20},
+	} {
+		b.startPipeline(ctx, done, p, 10*time.Millisecond)
+	}
+
+	// the in-flight erc20 blocks neither the nft nor the erc20 of the other bank
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&fetchedNFT) >= 3 && atomic.LoadInt32(&fetchedOther) >= 3
+	}, time.Second, time.Millisecond)
+	require.Zero(t, atomic.LoadInt32(&fetchedERC20))
+
+	// fetched once drained, the cursor of the last fetch is committed before the next
+	b.deleteEventMap("0xaa")
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&fetchedERC20) >= 2 }, time.Second, time.Millisecond)
+
+	close(done)
+	b.Wait()
+
+	block, err := b.Repo.GetConfirmedBlock(bank.Address, pb.BlockERC20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(atomic.LoadInt32(&fetchedERC20)-1)*10, block.Number)
+	require.Equal(t, uint64(atomic.LoadInt32(&fetchedERC20))*10, bank.ConfirmedBlockERC20.Number)
+}
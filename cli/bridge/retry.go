@@ -0,0 +1,387 @@
+package bridge
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/metrics"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+const (
+	ErrClassTxFailed          = "tx-failed"          // the mint tx is reverted
+	ErrClassNonceTooLow       = "nonce-too-low"      // the nonce is resynced before the retry
+	ErrClassUnderpriced       = "underpriced"        // the gas price is too low to replace or enter the pool
+	ErrClassInsufficientFunds = "insufficient-funds" // the relayer can not pay the gas, the event is paused
+	ErrClassReverted          = "reverted"           // the mint is reverted on the estimation
+	ErrClassRateLimited       = "rate-limited"       // the node refuses by the rate limit
+	ErrClassTimeout           = "timeout"            // the node does not respond in time
+	ErrClassPairDisabled      = "pair-disabled"      // the pair is disabled, the event is paused
+	ErrClassTxDropped         = "tx-dropped"         // the mint tx is cancelled by the nonce sync
+	ErrClassOther             = "other"              // not classified
+
+	RETRY_INTERVAL = 1 * time.Second
+)
+
+// Action is what the bridge does with the failed mint
+type Action int
+
+const (
+	// ActionRetry retries when the class is retryable by the policy, fails otherwise
+	ActionRetry Action = iota
+	// ActionResync resyncs the nonce of the relayer, then acts as ActionRetry
+	ActionResync
+	// ActionPause keeps the event paused until retried manually
+	ActionPause
+)
+
+var (
+	ErrInvalidRetryPolicy = errors.New("invalid retry policy")
+
+	DefaultRetryPolicy = RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 10 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+		Multiplier:     2,
+		Retryable:      []string{ErrClassTxFailed, ErrClassTxDropped, ErrClassNonceTooLow, ErrClassUnderpriced, ErrClassRateLimited, ErrClassTimeout},
+	}
+)
+
+// ErrClass names the kind of the mint failure and its action,
+// so that the retry policy can choose the retryable ones
+type ErrClass struct {
+	Name   string
+	Action Action
+	Match  func(err error) bool
+}
+
+func matchErr(target error) func(err error) bool {
+	return func(err error) bool { return errors.Is(err, target) }
+}
+
+// ErrClasses are matched in order, the last one matches any error
+var ErrClasses = []ErrClass{
+	{Name: ErrClassTxFailed, Action: ActionRetry, Match: matchErr(confirm.ErrTxFailed)},
+	{Name: ErrClassPairDisabled, Action: ActionPause, Match: matchErr(ErrPairDisabled)},
+	{Name: ErrClassTxDropped, Action: ActionRetry, Match: matchErr(client.ErrTxDropped)},
+	{Name: ErrClassNonceTooLow, Action: ActionResync, Match: matchErr(client.ErrNonceTooLow)},
+	{Name: ErrClassUnderpriced, Action: ActionRetry, Match: matchErr(client.ErrUnderpriced)},
+	{Name: ErrClassInsufficientFunds, Action: ActionPause, Match: matchErr(client.ErrInsufficientFunds)},
+	{Name: ErrClassReverted, Action: ActionRetry, Match: matchErr(client.ErrReverted)},
+	{Name: ErrClassRateLimited, Action: ActionRetry, Match: matchErr(client.ErrRateLimited)},
+	{Name: ErrClassTimeout, Action: ActionRetry, Match: matchErr(client.ErrTimeout)},
+	{Name: ErrClassOther, Action: ActionRetry, Match: func(err error) bool { return true }},
+}
+
+// ClassOf returns the first class matching the error
+func ClassOf(err error) ErrClass {
+	for _, c := range ErrClasses {
+		if c.Match(err) {
+			return c
+		}
+	}
+	return ErrClasses[len(ErrClasses)-1]
+}
+
+// Classify returns the name of the class of the error
+func Classify(err error) string {
+	return ClassOf(err).Name
+}
+
+// RetryPolicy decides whether and when the failed mint is retried.
+// the n-th retry waits `InitialBackoff * Multiplier^(n-1)`, capped by `MaxBackoff`
+type RetryPolicy struct {
+	MaxRetries     uint32
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Retryable      []string
+}
+
+func (p RetryPolicy) Validate() error {
+	if p.InitialBackoff <= 0 || p.MaxBackoff < p.InitialBackoff {
+		return fmt.Errorf("%w, the backoff must be 0 < initial(%s) <= max(%s)", ErrInvalidRetryPolicy, p.InitialBackoff, p.MaxBackoff)
+	}
+	if p.Multiplier < 1 {
+		return fmt.Errorf("%w, the multiplier(%f) must be >= 1", ErrInvalidRetryPolicy, p.Multiplier)
+	}
+	for _, name := range p.Retryable {
+		known := false
+		for _, c := range ErrClasses {
+			known = known || c.Name == name
+		}
+		if !known {
+			return fmt.Errorf("%w, unknown error class(%s)", ErrInvalidRetryPolicy, name)
+		}
+	}
+	return nil
+}
+
+// Backoff returns the delay before the retry, counted from 1
+func (p RetryPolicy) Backoff(retry uint32) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry)-1)
+	if d > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(d)
+}
+
+func (p RetryPolicy) IsRetryable(err error) bool {
+	return p.isRetryable(Classify(err))
+}
+
+func (p RetryPolicy) isRetryable(class string) bool {
+	for _, name := range p.Retryable {
+		if name == class {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Bridge) retryPolicy(typ string) RetryPolicy {
+	if p, ok := b.retryPolicies[typ]; ok {
+		return p
+	}
+	return DefaultRetryPolicy
+}
+
+// handleFailure acts on the failed mint by the class of the error,
+// schedules the retry, pauses or marks the event failed
+func (b *Bridge) handleFailure(h string, e pb.Event, err error) {
+	var (
+		p     = b.retryPolicy(e.Type())
+		class = ClassOf(err)
+	)
+
+	switch class.Action {
+	case ActionPause:
+		if class.Name == ErrClassInsufficientFunds && b.balancePolicy.enabled() {
+			b.holdForFunds(h, e, err)
+			return
+		}
+		b.pause(h, e, err)
+		return
+	case ActionResync:
+		if rerr := b.resyncNonce(); rerr != nil {
+			b.logger.Warn().Msgf("failed to resync nonce, err: %v", rerr)
+		}
+	}
+
+	if e.GetRetry() >= p.MaxRetries || !p.isRetryable(class.Name) {
+		b.logger.Warn().Msgf("failed handle %s log(%v), hash: %s, class: %s, err: %v", e.Type(), e, h, class.Name, err)
+		e.SetStatus(pb.EventStatus_FAILED)
+		e.SetNextAttemptAt(nil)
+		if perr := b.Repo.PutEvent(e); perr != nil {
+			b.logger.Warn().Msgf("failed to put event(%v), hash: %s, err: %v", e, h, perr)
+		}
+		metrics.EventsFailed.WithLabelValues(e.Type(), e.GetToken()).Inc()
+		b.audit(audit.ActionMintFailed, eventKey(e), eventDetails(e, audit.Detail("hash", h), audit.Detail("class", class.Name), audit.Detail("error", err))...)
+		b.popDetectedAt(e)
+		b.publish(StageFailed, h, e, err)
+		return
+	}
+
+	retry := e.GetRetry() + 1
+	at := time.Now().Add(p.Backoff(retry)).UTC()
+	e.SetRetry(retry)
+	e.SetNextAttemptAt(&at)
+
+	// persisted, so that the retry survives the restart
+	if perr := b.Repo.PutEvent(e); perr != nil {
+		b.logger.Warn().Msgf("failed to put event(%v), hash: %s, err: %v", e, h, perr)
+	}
+	b.retries.push(e, at)
+
+	b.logger.Info().Msgf("scheduled retry(%d) of event(%v) at %s, hash: %s, class: %s, err: %v", retry, e, at.Format(time.RFC3339), h, class.Name, err)
+	metrics.EventsRetried.WithLabelValues(e.Type(), e.GetToken()).Inc()
+	b.audit(audit.ActionMintRetried, eventKey(e), eventDetails(e,
+		audit.Detail("hash", h),
+		audit.Detail("class", class.Name),
+		audit.Detail("error", err),
+		audit.Detail("next_attempt_at", at.Format(time.RFC3339Nano)),
+	)...)
+	b.publish(StageRetried, h, e, err)
+}
+
+// pause keeps the event until retried manually, e.g. after the pair is enabled or the relayer is funded
+func (b *Bridge) pause(h string, e pb.Event, err error) {
+	b.logger.Warn().Msgf("paused event(%v), hash: %s, err: %v", e, h, err)
+	e.SetStatus(pb.EventStatus_PAUSED)
+	e.SetNextAttemptAt(nil)
+	if perr := b.Repo.PutEvent(e); perr != nil {
+		b.logger.Warn().Msgf("failed to put event(%v), hash: %s, err: %v", e, h, perr)
+	}
+	b.audit(audit.ActionEventPaused, eventKey(e), eventDetails(e, audit.Detail("hash", h), audit.Detail("error", err))...)
+	b.popDetectedAt(e)
+	b.publish(StagePaused, h, e, err)
+}
+
+// runRetries sends the due retries until the context is done
+func (b *Bridge) runRetries(ctx context.Context) {
+	ticker := time.NewTicker(RETRY_INTERVAL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.sendRetries(ctx, time.Now())
+			b.confirmUntracked(ctx)
+		}
+	}
+}
+
+func (b *Bridge) sendRetries(ctx context.Context, now time.Time) {
+	// kept in the queue until resumed
+	if b.MintingPaused() {
+		return
+	}
+
+	due := b.retries.popDue(now)
+	if len(due) == 0 {
+		return
+	}
+
+	if err := b.syncPairs(); err != nil {
+		b.logger.Warn().Msgf("failed to sync pairs, err: %v", err)
+	}
+
+	for _, e := range due {
+		// cleared before sending, so that the restart never sends it twice
+		e.SetNextAttemptAt(nil)
+		if err := b.Repo.PutEvent(e); err != nil {
+			b.logger.Warn().Msgf("failed to put event(%v), err: %v", e, err)
+		}
+
+		if _, err := b.send(ctx, e); err != nil {
+			b.handleFailure("", e, err)
+		}
+	}
+}
+
+// loadRetries restores the retries scheduled before the restart
+func (b *Bridge) loadRetries() error {
+	restore := func(e pb.Event) error {
+		if at := e.GetNextAttemptAt(); at != nil && e.GetStatus() == pb.EventStatus_UNDEFINED {
+			b.retries.push(e, *at)
+		}
+		return nil
+	}
+
+	if err := b.Repo.IterateEventsERC20(func(e *pb.EventERC20Deposited) error { return restore(e) }); err != nil {
+		return err
+	}
+	if err := b.Repo.IterateEventsNFT(func(e *pb.EventNFTDeposited) error { return restore(e) }); err != nil {
+		return err
+	}
+
+	if n := b.retries.Len(); n > 0 {
+		b.logger.Info().Msgf("restored %d scheduled retries", n)
+	}
+	return nil
+}
+
+type retryItem struct {
+	at    time.Time
+	e     pb.Event
+	key   string
+	index int
+}
+
+type retryHeap []*retryItem
+
+func (h retryHeap) Len() int           { return len(h) }
+func (h retryHeap) Less(i, j int) bool { return h[i].at.Before(h[j].at) }
+func (h retryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *retryHeap) Push(x interface{}) {
+	item := x.(*retryItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *retryHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return item
+}
+
+// retryQueue is the delayed queue of the events ordered by the attempt time
+type retryQueue struct {
+	sync.Mutex
+
+	items retryHeap
+	keys  map[string]*retryItem
+}
+
+func newRetryQueue() *retryQueue {
+	return &retryQueue{
+		keys: make(map[string]*retryItem),
+	}
+}
+
+// push schedules the event, replacing the one already scheduled
+func (q *retryQueue) push(e pb.Event, at time.Time) {
+	q.Lock()
+	defer q.Unlock()
+
+	key := eventKey(e)
+	if item, ok := q.keys[key]; ok {
+		item.at = at
+		item.e = e
+		heap.Fix(&q.items, item.index)
+		return
+	}
+
+	item := &retryItem{at: at, e: e, key: key}
+	heap.Push(&q.items, item)
+	q.keys[key] = item
+}
+
+func (q *retryQueue) remove(e pb.Event) bool {
+	q.Lock()
+	defer q.Unlock()
+
+	item, ok := q.keys[eventKey(e)]
+	if !ok {
+		return false
+	}
+	heap.Remove(&q.items, item.index)
+	delete(q.keys, item.key)
+	return true
+}
+
+func (q *retryQueue) popDue(now time.Time) (due []pb.Event) {
+	q.Lock()
+	defer q.Unlock()
+
+	for len(q.items) > 0 && !q.items[0].at.After(now) {
+		item := heap.Pop(&q.items).(*retryItem)
+		delete(q.keys, item.key)
+		due = append(due, item.e)
+	}
+	return
+}
+
+func (q *retryQueue) Len() int {
+	q.Lock()
+	defer q.Unlock()
+
+	return len(q.items)
+}
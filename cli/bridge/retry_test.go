@@ -0,0 +1,140 @@
+package bridge
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	p := RetryPolicy{
+		MaxRetries:     5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Retryable:      []string{ErrClassTxFailed},
+	}
+	require.NoError(t, p.Validate())
+
+	require.Equal(t, time.Second, p.Backoff(1))
+	require.Equal(t, 2*time.Second, p.Backoff(2))
+	require.Equal(t, 4*time.Second, p.Backoff(3))
+	require.Equal(t, 5*time.Second, p.Backoff(4))
+
+	require.True(t, p.IsRetryable(confirm.ErrTxFailed))
+	require.False(t, p.IsRetryable(errors.New("timeout")))
+
+	p.Retryable = []string{"unknown"}
+	require.ErrorIs(t, p.Validate(), ErrInvalidRetryPolicy)
+	p.Retryable, p.Multiplier = nil, 0.5
+	require.ErrorIs(t, p.Validate(), ErrInvalidRetryPolicy)
+}
+
+func TestRetryQueue(t *testing.T) {
+	var (
+		q   = newRetryQueue()
+		now = time.Now()
+		e1  = &pb.EventERC20Deposited{Id: "1"}
+		e2  = &pb.EventNFTDeposited{Id: "1"}
+		e3  = &pb.EventERC20Deposited{Id: "3"}
+	)
+
+	q.push(e1, now.Add(3*time.Second))
+	q.push(e2, now.Add(time.Second))
+	q.push(e3, now.Add(2*time.Second))
+	// rescheduled
+	q.push(e1, now.Add(-time.Second))
+	require.Equal(t, 3, q.Len())
+
+	require.True(t, q.remove(e3))
+	require.False(t, q.remove(e3))
+
+	require.Equal(t, []pb.Event{e1}, q.popDue(now))
+	require.Empty(t, q.popDue(now))
+	require.Equal(t, []pb.Event{e2}, q.popDue(now.Add(time.Second)))
+	require.Equal(t, 0, q.Len())
+}
+
+func TestScheduleRetry(t *testing.T) {
+	var (
+		s      = db.NewMemDB()
+		policy = RetryPolicy{MaxRetries: 1, InitialBackoff: time.Minute, MaxBackoff: time.Minute, Multiplier: 1, Retryable: []string{ErrClassTxFailed}}
+		newB   = func() *Bridge {
+			return &Bridge{
+				Repo:          pb.NewRepository(s),
+				logger:        log.Bridge(""),
+				detectedAt:    make(map[string]time.Time),
+				retryPolicies: map[string]RetryPolicy{pb.EventTypeERC20: policy},
+				retries:       newRetryQueue(),
+			}
+		}
+		b = newB()
+		e = &pb.EventERC20Deposited{Id: "1", Token: "0x5FbDB2315678afecb367f032d93F642f64180aa3"}
+	)
+
+	b.handleFailure("0x01", e, confirm.ErrTxFailed)
+	require.Equal(t, 1, b.retries.Len())
+
+	stored := &pb.EventERC20Deposited{Id: "1"}
+	require.NoError(t, b.Repo.GetEvent(stored))
+	require.Equal(t, uint32(1), stored.Retry)
+	require.NotNil(t, stored.NextAttemptAt)
+	require.WithinDuration(t, time.Now().Add(time.Minute), *stored.NextAttemptAt, time.Second)
+
+	// restored after the restart
+	restarted := newB()
+	require.NoError(t, restarted.loadRetries())
+	require.Equal(t, 1, restarted.retries.Len())
+
+	// exceeds the max retries
+	b.handleFailure("0x02", e, confirm.ErrTxFailed)
+	stored = &pb.EventERC20Deposited{Id: "1"}
+	require.NoError(t, b.Repo.GetEvent(stored))
+	require.Equal(t, pb.EventStatus_FAILED, stored.Status)
+	require.Nil(t, stored.NextAttemptAt)
+
+	// not retryable
+	e2 := &pb.EventERC20Deposited{Id: "2"}
+	b.handleFailure("0x03", e2, errors.New("timeout"))
+	require.Equal(t, pb.EventStatus_FAILED, e2.Status)
+}
+
+func TestClassOf(t *testing.T) {
+	require.Equal(t, ErrClassNonceTooLow, Classify(client.Classify(errors.New("nonce too low"))))
+	require.Equal(t, ActionResync, ClassOf(client.Classify(errors.New("nonce too low"))).Action)
+	require.Equal(t, ActionPause, ClassOf(client.Classify(errors.New("insufficient funds for gas * price + value"))).Action)
+	require.Equal(t, ActionPause, ClassOf(fmt.Errorf("%w, in-addr: 0x00", ErrPairDisabled)).Action)
+	require.Equal(t, ErrClassReverted, Classify(client.Classify(errors.New("execution reverted: only minter"))))
+	require.Equal(t, ErrClassTxFailed, Classify(confirm.ErrTxFailed))
+	require.Equal(t, ErrClassOther, Classify(errors.New("unknown")))
+
+	require.NoError(t, DefaultRetryPolicy.Validate())
+}
+
+func TestPauseOnInsufficientFunds(t *testing.T) {
+	var (
+		b = &Bridge{
+			Repo:          pb.NewRepository(db.NewMemDB()),
+			logger:        log.Bridge(""),
+			detectedAt:    make(map[string]time.Time),
+			retryPolicies: make(map[string]RetryPolicy),
+			retries:       newRetryQueue(),
+		}
+		e = &pb.EventNFTDeposited{Id: "1"}
+	)
+
+	b.handleFailure("", e, client.Classify(errors.New("insufficient funds for gas * price + value")))
+	require.Equal(t, 0, b.retries.Len())
+
+	stored := &pb.EventNFTDeposited{Id: "1"}
+	require.NoError(t, b.Repo.GetEvent(stored))
+	require.Equal(t, pb.EventStatus_PAUSED, stored.Status)
+}
@@ -0,0 +1,47 @@
+package bridge
+
+// Status is the snapshot of the running bridge, the confirmed blocks are the lowest of the banks
+type Status struct {
+	ConfirmedBlockERC20 uint64       `json:"confirmed_block_erc20"`
+	ConfirmedBlockNFT   uint64       `json:"confirmed_block_nft"`
+	InflightERC20       int          `json:"inflight_erc20"`
+	InflightNFT         int          `json:"inflight_nft"`
+	ConfirmerQueue      int          `json:"confirmer_queue"`
+	RetryQueue          int          `json:"retry_queue"`
+	Signer              string       `json:"signer"`
+	Nonce               uint64       `json:"nonce"`
+	Balance             string       `json:"balance"`
+	MintingPaused       bool         `json:"minting_paused"`
+	Banks               []BankStatus `json:"banks"`
+}
+
+type BankStatus struct {
+	Address             string `json:"address"`
+	ConfirmedBlockERC20 uint64 `json:"confirmed_block_erc20"`
+	ConfirmedBlockNFT   uint64 `json:"confirmed_block_nft"`
+}
+
+func (b *Bridge) Status() (s Status, err error) {
+	b.Lock()
+	s.ConfirmedBlockERC20, s.ConfirmedBlockNFT = b.lowestCursors()
+	for _, bk := range b.Banks {
+		s.Banks = append(s.Banks, BankStatus{Address: bk.Address, ConfirmedBlockERC20: bk.ConfirmedBlockERC20.Number, ConfirmedBlockNFT: bk.ConfirmedBlockNFT.Number})
+	}
+	s.InflightERC20 = len(b.EventMapERC20)
+	s.InflightNFT = len(b.EventMapNFT)
+	b.Unlock()
+
+	s.ConfirmerQueue = b.confirmer.QueueLen()
+	s.RetryQueue = b.retries.Len()
+	s.Signer = b.wallet.Address().Hex()
+
+	b.balance.RLock()
+	if b.balance.value != nil {
+		s.Balance = b.balance.value.String()
+	}
+	s.MintingPaused = b.balance.low
+	b.balance.RUnlock()
+
+	s.Nonce, err = b.wallet.Nonce.Current()
+	return
+}
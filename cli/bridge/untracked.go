@@ -0,0 +1,70 @@
+package bridge
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+// untracked holds the mint txs sent but rejected by the confirmer queue,
+// whose receipts are polled by the bridge instead
+type untracked struct {
+	// the hash sent by the last `EnqueueTx`, set by the hook under the lock of the nonces
+	sent   string
+	hashes map[string]struct{}
+	blocks uint64
+}
+
+func newUntracked() untracked {
+	return untracked{hashes: make(map[string]struct{}), blocks: confirm.DEFAULT_CONFIEMATION_BLOCKS}
+}
+
+// sentHandler marks the tx as sent, the enqueue may still fail after it
+func (b *Bridge) sentHandler(h string) error {
+	b.untracked.sent = h
+	return nil
+}
+
+func (b *Bridge) track(h string) {
+	b.Lock()
+	defer b.Unlock()
+
+	b.untracked.hashes[h] = struct{}{}
+}
+
+func (b *Bridge) untrackedHashes() (hashes []string) {
+	b.Lock()
+	defer b.Unlock()
+
+	for h := range b.untracked.hashes {
+		hashes = append(hashes, h)
+	}
+	return
+}
+
+func (b *Bridge) untrack(h string) {
+	b.Lock()
+	defer b.Unlock()
+
+	delete(b.untracked.hashes, h)
+}
+
+// confirmUntracked settles the untracked txs as the confirmer does
+func (b *Bridge) confirmUntracked(ctx context.Context) {
+	for _, h := range b.untrackedHashes() {
+		err := b.client.ConfirmTx(ctx, h, b.untracked.blocks)
+		if errors.Is(err, confirm.ErrTxNotFound) || errors.Is(err, confirm.ErrTxConfirmPending) {
+			continue
+		}
+		b.untrack(h)
+
+		if err != nil {
+			b.confirmerErrHandler(h, err)
+			continue
+		}
+		if err = b.confirmedHandler(h); err != nil {
+			b.confirmerErrHandler(h, err)
+		}
+	}
+}
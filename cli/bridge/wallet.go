@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/tak1827/nonce-incrementor/nonce"
 )
@@ -29,3 +30,17 @@ func NewWallet(ctx context.Context, client nonce.Client, privKey string) (w Wall
 func (w Wallet) IncrementNonce() (uint64, error) {
 	return w.Nonce.Increment()
 }
+
+// NextNonce returns the nonce of the next tx, which is not consumed until `UseNonce`
+func (w Wallet) NextNonce() (uint64, error) {
+	return w.Nonce.Current()
+}
+
+// UseNonce consumes the nonce once the tx of it is built, the next tx takes the following one
+func (w Wallet) UseNonce(n uint64) {
+	w.Nonce.Reset(n + 1)
+}
+
+func (w Wallet) Address() common.Address {
+	return crypto.PubkeyToAddress(w.priv.PublicKey)
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/tak1827/evm-bridge/cli/metrics"
+)
+
+// IBridgeWhitelistABI is the whitelist part of the Bridge contract abi
+const IBridgeWhitelistABI = `[
+{"inputs":[{"internalType":"uint256","name":"index","type":"uint256"}],"name":"getERC20Whitelist","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"countERC20Whitelist","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"internalType":"uint256","name":"index","type":"uint256"}],"name":"getNFTWhitelist","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"countNFTWhitelist","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+// the opcode pushing the function selector in the dispatcher of solidity contracts
+const opPush4 = 0x63
+
+func (c *ReadClient) CodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainIn, "CodeAt", start, err) }(time.Now())
+	return c.backend.CodeAt(ctx, account, nil)
+}
+
+func (c *Client) CodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainOut, "CodeAt", start, err) }(time.Now())
+	return c.backend.CodeAt(ctx, account, nil)
+}
+
+// Whitelisted reports whether the token is in the erc20 or the nft whitelist of the Bridge contract
+func (c *ReadClient) Whitelisted(ctx context.Context, bridgeAddr, token common.Address) (erc20, nft bool, err error) {
+	parsed, err := abi.JSON(strings.NewReader(IBridgeWhitelistABI))
+	if err != nil {
+		return
+	}
+	contract := bind.NewBoundContract(bridgeAddr, parsed, c.backend, nil, nil)
+
+	if erc20, err = whitelisted(ctx, contract, "ERC20", token); err != nil {
+		return
+	}
+	nft, err = whitelisted(ctx, contract, "NFT", token)
+	return
+}
+
+func whitelisted(ctx context.Context, contract *bind.BoundContract, kind string, token common.Address) (bool, error) {
+	var (
+		opts  = &bind.CallOpts{Context: ctx}
+		count []interface{}
+	)
+	if err := contract.Call(opts, &count, "count"+kind+"Whitelist"); err != nil {
+		return false, err
+	}
+
+	n := count[0].(*big.Int).Int64()
+	for i := int64(0); i < n; i++ {
+		var addr []interface{}
+		if err := contract.Call(opts, &addr, "get"+kind+"Whitelist", big.NewInt(i)); err != nil {
+			return false, err
+		}
+		if addr[0].(common.Address) == token {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CallERC20Mint simulates the `mint` of zero amount by the account, the revert is returned as the error
+func (c *Client) CallERC20Mint(ctx context.Context, from, token common.Address) error {
+	input, err := c.erc20ABI.Pack("mint", from, big.NewInt(0))
+	if err != nil {
+		return err
+	}
+	return c.call(ctx, from, token, input)
+}
+
+// CallNFTMint simulates the `safeMint` to the account, the revert is returned as the error.
+// the max token id is used, which is unlikely to be minted already
+func (c *Client) CallNFTMint(ctx context.Context, from, token common.Address) error {
+	input, err := c.nftABI.Pack("safeMint", math.MaxBig256, from, "")
+	if err != nil {
+		return err
+	}
+	return c.call(ctx, from, token, input)
+}
+
+// HasERC20Mint reports whether the code dispatches `mint`, always false for the proxy contracts
+func (c *Client) HasERC20Mint(code []byte) bool {
+	return hasSelector(code, c.erc20ABI.Methods["mint"].ID)
+}
+
+// HasNFTMint reports whether the code dispatches `safeMint`, always false for the proxy contracts
+func (c *Client) HasNFTMint(code []byte) bool {
+	return hasSelector(code, c.nftABI.Methods["safeMint"].ID)
+}
+
+func (c *Client) call(ctx context.Context, from, to common.Address, input []byte) (err error) {
+	msg := ethereum.CallMsg{
+		From:     from,
+		To:       &to,
+		GasPrice: c.GasPrice,
+		Data:     input,
+	}
+
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainOut, "CallContract", start, err) }(time.Now())
+	_, err = c.backend.CallContract(ctx, msg, nil)
+	err = Classify(err)
+	return
+}
+
+func hasSelector(code, id []byte) bool {
+	return bytes.Contains(code, append([]byte{opPush4}, id...))
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -17,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"github.com/tak1827/evm-bridge/cli/metrics"
 	"github.com/tak1827/nonce-incrementor/nonce"
 	"github.com/tak1827/transaction-confirmer/confirm"
 )
@@ -30,9 +33,20 @@ var (
 	_ nonce.Client   = (*Client)(nil)
 )
 
+// Backend is the node interface the clients depend on, satisfied by `*ethclient.Client`
+// and the simulated backend of go-ethereum, so that the clients are tested without the node
+type Backend interface {
+	bind.ContractBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+var _ Backend = (*ethclient.Client)(nil)
+
 type Client struct {
-	ethclient *ethclient.Client
-	GasPrice  *big.Int
+	backend  Backend
+	GasPrice *big.Int
 
 	erc20ABI abi.ABI
 	nftABI   abi.ABI
@@ -40,6 +54,9 @@ type Client struct {
 	bankAddr common.Address
 
 	CustomComfirm func(h string, recept *types.Receipt) error
+
+	// the hashes of the replaced txs, never mined
+	dropped *sync.Map
 }
 
 func NewClient(ctx context.Context, endpoint string, bankHex string, opts ...Option) (c Client, err error) {
@@ -49,9 +66,15 @@ func NewClient(ctx context.Context, endpoint string, bankHex string, opts ...Opt
 		return
 	}
 
-	c.ethclient = ethclient.NewClient(rpcclient)
+	return NewClientWithBackend(ethclient.NewClient(rpcclient), bankHex, opts...)
+}
+
+// NewClientWithBackend creates the client on the given backend, such as the simulated one
+func NewClientWithBackend(backend Backend, bankHex string, opts ...Option) (c Client, err error) {
+	c.backend = backend
 	c.GasPrice = big.NewInt(int64(DefaultGasPrice))
 	c.bankAddr = common.HexToAddress(bankHex)
+	c.dropped = &sync.Map{}
 
 	if c.erc20ABI, err = abi.JSON(strings.NewReader(IERC20ABI)); err != nil {
 		return
@@ -61,7 +84,7 @@ func NewClient(ctx context.Context, endpoint string, bankHex string, opts ...Opt
 		return
 	}
 
-	if c.Bank, err = NewIBank(c.bankAddr, c.ethclient); err != nil {
+	if c.Bank, err = NewIBank(c.bankAddr, c.backend); err != nil {
 		return
 	}
 
@@ -80,15 +103,28 @@ func (c *Client) Nonce(ctx context.Context, privKey string) (nonce uint64, err e
 	}
 
 	account := crypto.PubkeyToAddress(priv.PublicKey)
-	nonce, err = c.ethclient.NonceAt(ctx, account, nil)
+
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainOut, "NonceAt", start, err) }(time.Now())
+	nonce, err = c.backend.NonceAt(ctx, account, nil)
+	err = Classify(err)
+	return
+}
+
+func (c *Client) BalanceAt(ctx context.Context, account common.Address) (balance *big.Int, err error) {
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainOut, "BalanceAt", start, err) }(time.Now())
+	balance, err = c.backend.BalanceAt(ctx, account, nil)
+	err = Classify(err)
 	return
 }
 
 func (c *Client) SendTx(ctx context.Context, tx interface{}) (string, error) {
 	signedTx := tx.(*types.Transaction)
 
-	if err := c.ethclient.SendTransaction(ctx, signedTx); err != nil {
-		return "", errors.Wrap(err, "err SendTransaction")
+	start := time.Now()
+	err := c.backend.SendTransaction(ctx, signedTx)
+	metrics.ObserveRPC(metrics.ChainOut, "SendTransaction", start, err)
+	if err != nil {
+		return "", errors.Wrap(Classify(err), "err SendTransaction")
 	}
 
 	return signedTx.Hash().Hex(), nil
@@ -98,9 +134,12 @@ func (c *Client) ConfirmTx(ctx context.Context, hash string, confirmationBlocks
 	recept, err := c.Receipt(ctx, hash)
 	if err != nil {
 		if errors.Is(err, ethereum.NotFound) {
+			if c.isDropped(hash) {
+				return ErrTxDropped
+			}
 			return confirm.ErrTxNotFound
 		}
-		return errors.Wrap(err, "err TransactionReceipt")
+		return errors.Wrap(Classify(err), "err TransactionReceipt")
 	}
 
 	if c.CustomComfirm != nil {
@@ -125,18 +164,36 @@ func (c *Client) ConfirmTx(ctx context.Context, hash string, confirmationBlocks
 	return nil
 }
 
-func (c *Client) Receipt(ctx context.Context, hash string) (*types.Receipt, error) {
-	return c.ethclient.TransactionReceipt(ctx, common.HexToHash(hash))
+func (c *Client) Receipt(ctx context.Context, hash string) (recept *types.Receipt, err error) {
+	defer func(start time.Time) {
+		// not found is the expected result of pending txs
+		if errors.Is(err, ethereum.NotFound) {
+			metrics.ObserveRPC(metrics.ChainOut, "TransactionReceipt", start, nil)
+			return
+		}
+		metrics.ObserveRPC(metrics.ChainOut, "TransactionReceipt", start, err)
+	}(time.Now())
+	return c.backend.TransactionReceipt(ctx, common.HexToHash(hash))
 }
 
 func (c *Client) LatestBlockNumber(ctx context.Context) (uint64, error) {
-	header, err := c.ethclient.HeaderByNumber(ctx, nil)
+	start := time.Now()
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	metrics.ObserveRPC(metrics.ChainOut, "HeaderByNumber", start, err)
 	if err != nil {
-		return 0, err
+		return 0, Classify(err)
 	}
+	metrics.ChainHead.WithLabelValues(metrics.ChainOut).Set(float64(header.Number.Uint64()))
 	return header.Number.Uint64(), nil
 }
 
+func (c *Client) estimateGas(ctx context.Context, msg ethereum.CallMsg) (gas uint64, err error) {
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainOut, "EstimateGas", start, err) }(time.Now())
+	gas, err = c.backend.EstimateGas(ctx, msg)
+	err = Classify(err)
+	return
+}
+
 func (c *Client) BuildTx(priv *ecdsa.PrivateKey, nonce uint64, to common.Address, value *big.Int, gasLimit uint64, data []byte) (*types.Transaction, error) {
 	var (
 		tx     = types.NewTransaction(nonce, to, value, gasLimit, c.GasPrice, data)
@@ -163,7 +220,7 @@ func (c *Client) BuildERC20MintTx(ctx context.Context, priv *ecdsa.PrivateKey, n
 		}
 	)
 
-	gas, err := c.ethclient.EstimateGas(ctx, msg)
+	gas, err := c.estimateGas(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -171,10 +228,10 @@ func (c *Client) BuildERC20MintTx(ctx context.Context, priv *ecdsa.PrivateKey, n
 	return c.BuildTx(priv, nonce, to, nil, gas, input)
 }
 
-func (c *Client) BuildNFTMintTx(ctx context.Context, priv *ecdsa.PrivateKey, nonce uint64, to, account common.Address, tokenid *big.Int) (*types.Transaction, error) {
+func (c *Client) BuildNFTMintTx(ctx context.Context, priv *ecdsa.PrivateKey, nonce uint64, to, account common.Address, tokenid *big.Int, uri string) (*types.Transaction, error) {
 	var (
 		auth     = bind.NewKeyedTransactor(priv)
-		input, _ = c.nftABI.Pack("safeMint", tokenid, account, "")
+		input, _ = c.nftABI.Pack("safeMint", tokenid, account, uri)
 		msg      = ethereum.CallMsg{
 			From:     auth.From,
 			To:       &to,
@@ -183,7 +240,7 @@ func (c *Client) BuildNFTMintTx(ctx context.Context, priv *ecdsa.PrivateKey, non
 		}
 	)
 
-	gas, err := c.ethclient.EstimateGas(ctx, msg)
+	gas, err := c.estimateGas(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -207,10 +264,9 @@ func (c *Client) DepositERC20(ctx context.Context, priv *ecdsa.PrivateKey, nonce
 	return c.Bank.DepositERC20(opts, token, auth.From, a)
 }
 
-func (c *Client) DepositNFT(ctx context.Context, priv *ecdsa.PrivateKey, nonce *big.Int, token common.Address, tokenid int64) (*types.Transaction, error) {
+func (c *Client) DepositNFT(ctx context.Context, priv *ecdsa.PrivateKey, nonce *big.Int, token common.Address, tokenid *big.Int) (*types.Transaction, error) {
 	var (
 		auth = bind.NewKeyedTransactor(priv)
-		id   = big.NewInt(tokenid)
 		opts = &bind.TransactOpts{
 			From:     auth.From,
 			Nonce:    nonce, // nil = use pending state
@@ -220,7 +276,7 @@ func (c *Client) DepositNFT(ctx context.Context, priv *ecdsa.PrivateKey, nonce *
 			Context:  ctx,
 		}
 	)
-	return c.Bank.DepositNFT(opts, token, auth.From, id)
+	return c.Bank.DepositNFT(opts, token, auth.From, tokenid)
 }
 
 func (c *Client) Deposit(ctx context.Context, priv *ecdsa.PrivateKey, nonce *big.Int, amount int64) (*types.Transaction, error) {
@@ -241,7 +297,7 @@ func (c *Client) Deposit(ctx context.Context, priv *ecdsa.PrivateKey, nonce *big
 }
 
 type ReadClient struct {
-	ethclient *ethclient.Client
+	backend Backend
 
 	Bank     *IBank
 	bankAddr common.Address
@@ -254,21 +310,38 @@ func NewReadClient(ctx context.Context, endpoint string, bankHex string) (c Read
 		return
 	}
 
-	c.ethclient = ethclient.NewClient(rpcclient)
+	return NewReadClientWithBackend(ethclient.NewClient(rpcclient), bankHex)
+}
+
+// NewReadClientWithBackend creates the read client on the given backend, such as the simulated one
+func NewReadClientWithBackend(backend Backend, bankHex string) (c ReadClient, err error) {
+	c.backend = backend
 	c.bankAddr = common.HexToAddress(bankHex)
 
-	if c.Bank, err = NewIBank(c.bankAddr, c.ethclient); err != nil {
+	if c.Bank, err = NewIBank(c.bankAddr, c.backend); err != nil {
 		return
 	}
 
 	return
 }
 
+// ForBank returns the read client of the other bank, sharing the connection
+func (c *ReadClient) ForBank(bankHex string) (ReadClient, error) {
+	return NewReadClientWithBackend(c.backend, bankHex)
+}
+
+func (c *ReadClient) BankAddress() common.Address {
+	return c.bankAddr
+}
+
 func (c *ReadClient) LatestBlockNumber(ctx context.Context) (uint64, error) {
-	header, err := c.ethclient.HeaderByNumber(ctx, nil)
+	start := time.Now()
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	metrics.ObserveRPC(metrics.ChainIn, "HeaderByNumber", start, err)
 	if err != nil {
-		return 0, err
+		return 0, Classify(err)
 	}
+	metrics.ChainHead.WithLabelValues(metrics.ChainIn).Set(float64(header.Number.Uint64()))
 	return header.Number.Uint64(), nil
 }
 
@@ -279,9 +352,11 @@ func (c *ReadClient) FilterERC20Deposited(ctx context.Context, start uint64, end
 		Context: ctx,
 	}
 
+	called := time.Now()
 	it, err := c.Bank.FilterERC20Deposited(&opt, nil, nil)
+	metrics.ObserveRPC(metrics.ChainIn, "FilterLogs", called, err)
 	if err != nil {
-		return err
+		return Classify(err)
 	}
 
 	for it.Next() {
@@ -290,7 +365,7 @@ func (c *ReadClient) FilterERC20Deposited(ctx context.Context, start uint64, end
 		}
 	}
 
-	return nil
+	return it.Error()
 }
 
 func (c *ReadClient) FilterNFTDeposited(ctx context.Context, start uint64, end *uint64, handle func(e *IBankNFTDeposited) error) error {
@@ -300,9 +375,11 @@ func (c *ReadClient) FilterNFTDeposited(ctx context.Context, start uint64, end *
 		Context: ctx,
 	}
 
+	called := time.Now()
 	it, err := c.Bank.FilterNFTDeposited(&opt, nil, nil)
+	metrics.ObserveRPC(metrics.ChainIn, "FilterLogs", called, err)
 	if err != nil {
-		return err
+		return Classify(err)
 	}
 
 	for it.Next() {
@@ -311,7 +388,7 @@ func (c *ReadClient) FilterNFTDeposited(ctx context.Context, start uint64, end *
 		}
 	}
 
-	return nil
+	return it.Error()
 }
 
 func GenerateAddr() (addr common.Address, err error) {
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// the error code of the rate limited request, used by infura and geth
+const rpcCodeLimitExceeded = -32005
+
+var (
+	ErrNonceTooLow       = errors.New("nonce too low")
+	ErrUnderpriced       = errors.New("transaction underpriced")
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrReverted          = errors.New("execution reverted")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrTimeout           = errors.New("timeout")
+	ErrTxDropped         = errors.New("tx is dropped")
+)
+
+// NodeError is the classified error of the node, which matches its kind by `errors.Is`
+type NodeError struct {
+	Kind   error
+	Reason string // the revert reason, when the kind is ErrReverted
+	Err    error
+}
+
+func (e *NodeError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("%s(%s): %s", e.Kind.Error(), e.Reason, e.Err.Error())
+	}
+	return fmt.Sprintf("%s: %s", e.Kind.Error(), e.Err.Error())
+}
+
+func (e *NodeError) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *NodeError) Unwrap() error {
+	return e.Err
+}
+
+// the node messages of the kinds, the first match wins.
+// covers geth, ganache and the common hosted nodes
+var nodeMessages = []struct {
+	kind     error
+	messages []string
+}{
+	{ErrNonceTooLow, []string{"nonce too low", "nonce is too low", "correct nonce"}},
+	{ErrUnderpriced, []string{"underpriced", "gas price too low", "fee too low"}},
+	{ErrInsufficientFunds, []string{"insufficient funds", "sender doesn't have enough funds"}},
+	{ErrReverted, []string{"execution reverted", "vm exception while processing transaction: revert", "revert"}},
+	{ErrRateLimited, []string{"rate limit", "too many requests", "limit exceeded", "daily request count exceeded"}},
+	{ErrTimeout, []string{"timeout", "timed out", "deadline exceeded"}},
+}
+
+// Classify wraps the error of the node by NodeError, when the kind is known.
+// the error is returned as it is otherwise
+func Classify(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var ne *NodeError
+	if errors.As(err, &ne) {
+		return err
+	}
+
+	kind := classify(err)
+	if kind == nil {
+		return err
+	}
+
+	ne = &NodeError{Kind: kind, Err: err}
+	if kind == ErrReverted {
+		ne.Reason = revertReason(err)
+	}
+	return ne
+}
+
+func classify(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrTimeout
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return ErrRateLimited
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == rpcCodeLimitExceeded {
+		return ErrRateLimited
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, m := range nodeMessages {
+		for _, s := range m.messages {
+			if strings.Contains(msg, s) {
+				return m.kind
+			}
+		}
+	}
+
+	return nil
+}
+
+// revertReason decodes the `Error(string)` of the revert data, or picks the reason from the message
+func revertReason(err error) string {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			if reason, uerr := abi.UnpackRevert(common.FromHex(data)); uerr == nil {
+				return reason
+			}
+		}
+	}
+
+	msg := err.Error()
+	for _, prefix := range []string{"execution reverted: ", "revert "} {
+		if i := strings.Index(msg, prefix); i >= 0 {
+			return strings.TrimSpace(msg[i+len(prefix):])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testRPCError struct {
+	msg  string
+	code int
+	data interface{}
+}
+
+func (e testRPCError) Error() string          { return e.msg }
+func (e testRPCError) ErrorCode() int         { return e.code }
+func (e testRPCError) ErrorData() interface{} { return e.data }
+
+func TestClassify(t *testing.T) {
+	cases := []struct {
+		err  error
+		kind error
+	}{
+		{errors.New("nonce too low"), ErrNonceTooLow},
+		{errors.New("replacement transaction underpriced"), ErrUnderpriced},
+		{errors.New("insufficient funds for gas * price + value"), ErrInsufficientFunds},
+		{errors.New("VM Exception while processing transaction: revert not whitelisted"), ErrReverted},
+		{rpc.HTTPError{StatusCode: http.StatusTooManyRequests, Status: "429 Too Many Requests"}, ErrRateLimited},
+		{testRPCError{msg: "project ID request rate exceeded", code: rpcCodeLimitExceeded}, ErrRateLimited},
+		{fmt.Errorf("call: %w", context.DeadlineExceeded), ErrTimeout},
+		{pkgerrors.Wrap(errors.New("nonce too low"), "err SendTransaction"), ErrNonceTooLow},
+	}
+
+	for _, c := range cases {
+		err := Classify(c.err)
+		require.ErrorIs(t, err, c.kind, c.err.Error())
+		// the original error is kept
+		require.Contains(t, err.Error(), c.err.Error())
+	}
+
+	unknown := errors.New("unknown")
+	require.Equal(t, unknown, Classify(unknown))
+	require.Nil(t, Classify(nil))
+}
+
+func TestRevertReason(t *testing.T) {
+	// Error("not whitelisted")
+	data := "0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000f" +
+		"6e6f742077686974656c69737465640000000000000000000000000000000000"
+
+	var ne *NodeError
+	require.True(t, errors.As(Classify(testRPCError{msg: "execution reverted", code: 3, data: data}), &ne))
+	require.Equal(t, "not whitelisted", ne.Reason)
+
+	require.True(t, errors.As(Classify(errors.New("execution reverted: only minter")), &ne))
+	require.Equal(t, "only minter", ne.Reason)
+}
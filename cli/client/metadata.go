@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/metrics"
+)
+
+// IERC721MetadataABI is the `tokenURI` of the erc721 metadata extension
+const IERC721MetadataABI = `[
+{"inputs":[{"internalType":"uint256","name":"tokenId","type":"uint256"}],"name":"tokenURI","outputs":[{"internalType":"string","name":"","type":"string"}],"stateMutability":"view","type":"function"}
+]`
+
+var (
+	ErrNoTokenURI = errors.New("token uri is not supported")
+)
+
+// TokenURI returns the token uri of the in chain nft.
+// ErrNoTokenURI is returned when the nft does not implement the metadata extension
+func (c *ReadClient) TokenURI(ctx context.Context, token common.Address, tokenid *big.Int) (uri string, err error) {
+	parsed, err := abi.JSON(strings.NewReader(IERC721MetadataABI))
+	if err != nil {
+		return
+	}
+	input, err := parsed.Pack("tokenURI", tokenid)
+	if err != nil {
+		return
+	}
+
+	called := time.Now()
+	out, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &token, Data: input}, nil)
+	metrics.ObserveRPC(metrics.ChainIn, "CallContract", called, err)
+	if err = Classify(err); errors.Is(err, ErrReverted) || (err == nil && len(out) == 0) {
+		err = ErrNoTokenURI
+	}
+	if err != nil {
+		return
+	}
+
+	values, err := parsed.Unpack("tokenURI", out)
+	if err != nil {
+		return
+	}
+	uri, _ = values[0].(string)
+	return
+}
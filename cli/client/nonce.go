@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tak1827/evm-bridge/cli/metrics"
+)
+
+const (
+	// the gas of the plain transfer
+	TransferGas = uint64(21000)
+)
+
+// NonceAt returns the nonce of the latest block, which counts the mined txs only
+func (c *Client) NonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainOut, "NonceAt", start, err) }(time.Now())
+	nonce, err = c.backend.NonceAt(ctx, account, nil)
+	err = Classify(err)
+	return
+}
+
+// PendingNonceAt returns the next nonce counting the txs in the mem pool
+func (c *Client) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	defer func(start time.Time) { metrics.ObserveRPC(metrics.ChainOut, "PendingNonceAt", start, err) }(time.Now())
+	nonce, err = c.backend.PendingNonceAt(ctx, account)
+	err = Classify(err)
+	return
+}
+
+// BuildCancelTx builds the zero value transfer to the signer itself,
+// which fills the nonce gap, or replaces the pending tx of the nonce when the gas price is higher
+func (c *Client) BuildCancelTx(priv *ecdsa.PrivateKey, nonce uint64, gasPrice *big.Int) (*types.Transaction, error) {
+	var (
+		self = crypto.PubkeyToAddress(priv.PublicKey)
+		tx   = types.NewTransaction(nonce, self, big.NewInt(0), TransferGas, gasPrice, nil)
+	)
+	return types.SignTx(tx, types.HomesteadSigner{}, priv)
+}
+
+// Drop marks the tx as dropped, the confirmation of it fails with `ErrTxDropped` instead of waiting forever
+func (c *Client) Drop(hash string) {
+	c.dropped.Store(hash, struct{}{})
+}
+
+func (c *Client) isDropped(hash string) bool {
+	_, ok := c.dropped.Load(hash)
+	if ok {
+		c.dropped.Delete(hash)
+	}
+	return ok
+}
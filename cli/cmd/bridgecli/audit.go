@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tak1827/evm-bridge/cli/audit"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+var (
+	AuditHead string
+)
+
+var auditLogCmd = &cobra.Command{
+	Use:                        "audit-log",
+	Short:                      "verify or export the hash chained audit log",
+	SuggestionsMinimumDistance: 2,
+}
+
+var auditLogVerifyCmd = &cobra.Command{
+	Use:   "verify [file]",
+	Short: "verify the audit log is not altered",
+	Long: `Verify the chain of the audit log in the db, or in the exported file when given.
+Each entry commits to the previous one by the hmac keyed by "BRIDGECLI_AUDIT_KEY", so the log rewritten as a whole
+fails without the key. the same key as the bridge is required. without the key, keep the printed head hash elsewhere
+and compare it later by "--head" to detect the rewritten log`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var (
+			head  *pb.AuditEntry
+			count uint64
+			err   error
+		)
+
+		if len(args) == 1 {
+			f, ferr := os.Open(args[0])
+			handleErr(ferr)
+			defer f.Close()
+
+			setupAuditKey()
+			head, count, err = audit.VerifyExport(f)
+		} else {
+			getConfig()
+
+			repo := openRepo()
+			defer repo.Close()
+
+			head, count, err = audit.Verify(repo)
+		}
+		handleErr(err)
+
+		if head == nil {
+			fmt.Println("no entries")
+			return
+		}
+
+		hash := hex.EncodeToString(head.Hash)
+		fmt.Printf("entries: %d\n", count)
+		fmt.Printf("head: seq %d, hash %s\n", head.Seq, hash)
+
+		if AuditHead != "" && !strings.EqualFold(strings.TrimPrefix(AuditHead, "0x"), hash) {
+			logger.Fatal().Msgf("the head hash mismatches, expected: %s", AuditHead)
+		}
+		fmt.Println("verified!")
+	},
+}
+
+var auditLogExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export the audit log as newline delimited json",
+	Long:  `Export the verified audit log of the stopped bridge. The export is verified offline by "audit-log verify [file]"`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		repo := openRepo()
+		defer repo.Close()
+
+		var buf bytes.Buffer
+		count, err := audit.Export(repo, &buf)
+		handleErr(err)
+		handleErr(writeOutput(buf.Bytes()))
+
+		// stdout may be the export itself
+		fmt.Fprintf(os.Stderr, "exported, entries: %d\n", count)
+	},
+}
+
+// setupAuditKey keys the chain of the audit log by the env variable, as the other secrets
+func setupAuditKey() {
+	audit.SetKey([]byte(viper.GetString("audit_key")))
+}
+
+func init() {
+	auditLogVerifyCmd.Flags().StringVar(&AuditHead, "head", "", "the head hash recorded before, compared with the verified head")
+	auditLogExportCmd.Flags().StringVarP(&Output, "output", "o", "", "the file to write, stdout when empty")
+	auditLogCmd.AddCommand(auditLogVerifyCmd)
+	auditLogCmd.AddCommand(auditLogExportCmd)
+	rootCmd.AddCommand(auditLogCmd)
+}
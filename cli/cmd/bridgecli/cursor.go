@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	b "github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+var (
+	AssumeYes  bool
+	CursorBank string
+)
+
+var cursorCmd = &cobra.Command{
+	Use:                        "cursor",
+	Short:                      "inspect or reset the confirmed blocks, from which the logs are fetched",
+	SuggestionsMinimumDistance: 2,
+}
+
+var cursorGetCmd = &cobra.Command{
+	Use:   "get [erc20|nft]",
+	Short: "show the stored confirmed blocks of the banks",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		repo := openRepo()
+		defer repo.Close()
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "BANK\tTYPE\tBLOCK\tUPDATED-AT")
+		handleErr(repo.IterateConfirmedBlocks(func(bank string, t pb.BlockType, m *pb.ConfirmedBlock) error {
+			if len(args) == 1 && args[0] != t.EventType() {
+				return nil
+			}
+
+			updatedAt := "-"
+			if m.UpdatedAt != nil {
+				updatedAt = m.UpdatedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", bank, t.EventType(), m.Number, updatedAt)
+			return nil
+		}))
+		w.Flush()
+	},
+}
+
+var cursorSetCmd = &cobra.Command{
+	Use:   "set [erc20|nft] [block]",
+	Short: "reset the confirmed block",
+	Long: `Reset the confirmed block of the bank, the next fetch starts from the block. stop serving before.
+"--bank" can be omitted when the single bank is configured.
+Moving it back re-fetches the logs, the succeeded deposits are skipped.
+Moving it forward skips the deposits in between, use "replay" to mint them later`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		number, err := cast.ToUint64E(args[1])
+		handleErr(err)
+
+		repo := openRepo()
+		defer repo.Close()
+
+		bank := cursorBank()
+		current, err := b.GetCursor(repo, bank, args[0])
+		handleErr(err)
+
+		msg := fmt.Sprintf("reset the %s cursor of bank(%s) from %d to %d.", args[0], bank, current.Number, number)
+		if number > current.Number {
+			msg += fmt.Sprintf(" the deposits in the blocks %d to %d are not fetched.", current.Number, number-1)
+		}
+		if !confirmPrompt(msg + " continue?") {
+			fmt.Println("canceled")
+			return
+		}
+
+		_, err = b.SetCursor(repo, bank, args[0], number)
+		handleErr(err)
+
+		fmt.Println("succeeded!")
+	},
+}
+
+// cursorBank returns "--bank", or the bank when the single one is configured
+func cursorBank() string {
+	if CursorBank != "" {
+		return CursorBank
+	}
+	banks := viper.GetStringSlice("bank")
+	if len(banks) != 1 {
+		logger.Fatal().Msgf("please specify the bank by \"--bank\", configured: %v", banks)
+	}
+	return banks[0]
+}
+
+// confirmPrompt asks yes or no on the terminal, always yes with "--yes"
+func confirmPrompt(msg string) bool {
+	if AssumeYes {
+		return true
+	}
+
+	fmt.Printf("%s [y/N]: ", msg)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		handleErr(err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+func init() {
+	cursorSetCmd.Flags().BoolVarP(&AssumeYes, "yes", "y", false, "reset without the confirmation")
+	cursorSetCmd.Flags().StringVar(&CursorBank, "bank", "", "the bank of the cursor")
+	cursorCmd.AddCommand(cursorGetCmd)
+	cursorCmd.AddCommand(cursorSetCmd)
+	rootCmd.AddCommand(cursorCmd)
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tak1827/evm-bridge/cli/backup"
+	"github.com/tak1827/evm-bridge/cli/schema"
+)
+
+const (
+	SNAPSHOT_TIMEOUT = 10 * time.Minute
+)
+
+var (
+	DryRun bool
+	Output string
+)
+
+var dbCmd = &cobra.Command{
+	Use:                        "db",
+	Short:                      "manage the bridge db",
+	SuggestionsMinimumDistance: 2,
+}
+
+var dbVersionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "show the db schema version",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		s := openDB()
+		defer s.Close()
+
+		current, err := schema.Version(s)
+		handleErr(err)
+
+		fmt.Printf("current: %d\n", current)
+		fmt.Printf("latest: %d\n", schema.Migrations.Latest())
+	},
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "migrate the db schema to the latest version",
+	Long:  `Apply the pending migrations in order. With "--dry-run", show the changes without writing them`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		s := openDB()
+		defer s.Close()
+
+		results, err := schema.Migrations.Migrate(s, migrationEnv(), DryRun)
+		handleErr(err)
+
+		if len(results) == 0 {
+			fmt.Println("already up to date")
+			return
+		}
+
+		for _, r := range results {
+			fmt.Printf("version %d(%s): writes: %d, deletes: %d\n", r.Migration.Version, r.Migration.Name, r.Writes, r.Deletes)
+		}
+
+		if DryRun {
+			fmt.Println("dry run, nothing is written")
+			return
+		}
+		fmt.Println("succeeded!")
+	},
+}
+
+var dbExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export the pairs, events and cursors as newline delimited json",
+	Long: `Export all records of the stopped bridge. While "serve" is running, use "db snapshot" instead,
+since the leveldb is locked by the running process`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		repo := openRepo()
+		defer repo.Close()
+
+		var buf bytes.Buffer
+		sum, err := backup.Export(repo, &buf)
+		handleErr(err)
+		handleErr(writeOutput(buf.Bytes()))
+
+		// stdout may be the export itself
+		fmt.Fprintf(os.Stderr, "exported, %s\n", sum)
+	},
+}
+
+var dbImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "import the exported records into the fresh home",
+	Long:  `Validate all records of the export, then load them. The home must have no records`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		f, err := os.Open(args[0])
+		handleErr(err)
+		defer f.Close()
+
+		repo := openRepo()
+		defer repo.Close()
+
+		sum, err := backup.Import(repo, f)
+		handleErr(err)
+
+		fmt.Printf("imported, %s\n", sum)
+		if sum.Schema < schema.Migrations.Latest() {
+			fmt.Println("the schema is old, run `db migrate` or `serve` to migrate")
+		}
+	},
+}
+
+var dbSnapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "export the consistent snapshot of the running bridge",
+	Long:  `Download the export of the point in time snapshot from the admin api of the running "serve", then verify it`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		if APIAddress == "" {
+			if APIAddress = viper.GetString("api.address"); APIAddress == "" {
+				logger.Fatal().Msg("no `api.address` setting")
+			}
+		}
+		if APIToken = viper.GetString("api_token"); APIToken == "" {
+			logger.Fatal().Msg("please set `BRIDGECLI_API_TOKEN` as the env variable")
+		}
+
+		url := APIAddress
+		if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+			url = "http://" + url
+		}
+		req, err := http.NewRequest(http.MethodGet, url+"/db/snapshot", nil)
+		handleErr(err)
+		req.Header.Set("Authorization", "Bearer "+APIToken)
+
+		res, err := (&http.Client{Timeout: SNAPSHOT_TIMEOUT}).Do(req)
+		handleErr(err)
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		handleErr(err)
+		if res.StatusCode != http.StatusOK {
+			logger.Fatal().Msgf("failed to take snapshot, status: %d, body: %s", res.StatusCode, strings.TrimSpace(string(body)))
+		}
+
+		sum, err := backup.Verify(bytes.NewReader(body))
+		handleErr(err)
+		handleErr(writeOutput(body))
+
+		fmt.Fprintf(os.Stderr, "snapshot taken, %s\n", sum)
+	},
+}
+
+// writeOutput writes to the `--output` file, or stdout when not set.
+// the file is replaced at once, so that the partial file is never left
+func writeOutput(b []byte) error {
+	if Output == "" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(Output), filepath.Base(Output)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), Output)
+}
+
+func init() {
+	dbExportCmd.Flags().StringVarP(&Output, "output", "o", "", "the file to write, stdout when empty")
+	dbSnapshotCmd.Flags().StringVarP(&Output, "output", "o", "", "the file to write, stdout when empty")
+	dbSnapshotCmd.Flags().StringVar(&APIAddress, "api-address", "", "the address of the api of the running bridge")
+	dbCmd.AddCommand(dbExportCmd)
+	dbCmd.AddCommand(dbImportCmd)
+	dbCmd.AddCommand(dbSnapshotCmd)
+	dbMigrateCmd.Flags().BoolVar(&DryRun, "dry-run", false, "show the pending migrations without applying")
+	dbCmd.AddCommand(dbVersionCmd)
+	dbCmd.AddCommand(dbMigrateCmd)
+	rootCmd.AddCommand(dbCmd)
+}
+
+// migrationEnv reads the first bank, which the records stored before namespaced belong to
+func migrationEnv() (env schema.Env) {
+	if banks := viper.GetStringSlice("bank"); len(banks) > 0 {
+		env.Bank = banks[0]
+	}
+	return
+}
@@ -19,12 +19,47 @@ in-endpoint = "http://localhost:8545"
 # the out blockchain endpoint
 out-endpoint = "http://localhost:8545"
 
-# the bank contract address
-bank = "0x4c2310DAdb5Be92a39336316f841e1944DA7bd60"
+# the bank contract address, or the list of them watched in a process, e.g. while migrating to the new bank
+# NOTE: the events and the cursors are kept by the bank. list the bank watched so far first,
+#       the records stored before the multiple banks were supported are moved to it
+bank = ["0x4c2310DAdb5Be92a39336316f841e1944DA7bd60"]
+# the bridge contract address on the in chain, the pairs are validated against its whitelist
+# NOTE: required by "pair set" and "pair import" unless "--skip-checks",
+#       and by the pair set through the apis unless "skip_checks" is requested
+bridge = ""
 
-# the log fetching interval (milisec)
+# the log fetching interval (milisec), erc20 and nft are fetched independently
+# NOTE: the next fetch of the asset waits until its in-flight txs are confirmed, then starts at once
 log-fetch-interval = 10000
 
+###############################################################################
+###                          Log Configuration                              ###
+###############################################################################
+# NOTE: overridden by "--log-level", "--log-format" and "--log-file"
+[log]
+# "trace", "debug", "info", "warn", "error" or "fatal"
+level = "debug"
+# "console" or "json", the json is for shipping to the log pipeline
+format = "console"
+# the file to write, stdout when empty
+file = ""
+# the file is rotated when it exceeds the size (MB), 0 disables the rotation
+max-size = 100
+# the number of the rotated files kept as "<file>.1", "<file>.2", ..
+max-backups = 5
+
+# the levels of the modules, "bridge", "cli", "api", "grpc" and "webhook", overriding the level above
+[log.modules]
+# bridge = "info"
+
+###############################################################################
+###                           DB Configuration                              ###
+###############################################################################
+[db]
+# the storage backend, "leveldb", "memory" or "sqlite"
+# NOTE: "memory" loses all records on exit, intended for tests and dry runs
+backend = "leveldb"
+
 ###############################################################################
 ###                  Transaction Confirmer Configuration                    ###
 ###############################################################################
@@ -35,6 +70,105 @@ workers = 2
 confirmation-blocks = 2
 # the confirmation interval (milisec)
 interval = 10
+
+###############################################################################
+###                          Retry Configuration                            ###
+###############################################################################
+# the failed mint is retried after the backoff, "initial-backoff * multiplier^(n-1)" for the n-th retry
+# the error classes: "tx-failed" the mint tx is reverted, "nonce-too-low", "underpriced", "insufficient-funds",
+# "reverted" the estimation is reverted, "rate-limited", "timeout", "pair-disabled",
+# "tx-dropped" the mint tx is cancelled by the nonce sync, "other" not classified
+# NOTE: "nonce-too-low" resyncs the nonce, "insufficient-funds" and "pair-disabled" pause the event regardless of the list
+[retry.erc20]
+max-retries = 3
+# the backoff (milisec)
+initial-backoff = 10000
+max-backoff = 600000
+multiplier = 2.0
+retryable-errors = ["tx-failed", "tx-dropped", "nonce-too-low", "underpriced", "rate-limited", "timeout"]
+
+[retry.nft]
+max-retries = 3
+initial-backoff = 10000
+max-backoff = 600000
+multiplier = 2.0
+retryable-errors = ["tx-failed", "tx-dropped", "nonce-too-low", "underpriced", "rate-limited", "timeout"]
+
+###############################################################################
+###                           Nonce Configuration                           ###
+###############################################################################
+[nonce]
+# the local nonce is compared with the chain every interval (milisec), 0 compares only on "nonce-too-low"
+# the nonces used by others are skipped, and the gaps by the dropped txs are filled by the self transfers
+sync-interval = 30000
+# the lowest pending tx not mined in the timeout (milisec) is cancelled by the self transfer, 0 never cancels
+# NOTE: the mint of the cancelled tx is retried as "tx-dropped"
+stuck-timeout = 300000
+# the gas price of the cancel tx is bumped by the percent for each attempt, at least 10
+gas-bump = 10
+
+###############################################################################
+###                          Balance Configuration                          ###
+###############################################################################
+[balance]
+# the balance of the relayer on the out chain is checked every interval (milisec)
+check-interval = 60000
+# warned below the balance (wei in decimal string), disabled when empty
+warn-below = ""
+# the minting is paused below the balance (wei in decimal string), resumed once topped up. disabled when empty
+# NOTE: the fetch and the retries wait meanwhile, no retry is consumed
+pause-below = ""
+
+###############################################################################
+###                           API Configuration                             ###
+###############################################################################
+[api]
+# the listen address of the status and admin http api, disabled when empty
+# the prometheus metrics are exposed on "/metrics" of this address
+# NOTE: the admin endpoints require the token set by "BRIDGECLI_API_TOKEN" env variable
+address = "127.0.0.1:8080"
+
+###############################################################################
+###                           gRPC Configuration                            ###
+###############################################################################
+[grpc]
+# the listen address of the BridgeService grpc server, disabled when empty
+# NOTE: the admin methods require the same token as the api
+address = "127.0.0.1:9090"
+
+###############################################################################
+###                          Webhook Configuration                          ###
+###############################################################################
+[webhook]
+# the lifecycle notifications of the events are stored in the outbox of the db, then posted to the sinks as json
+# the body is signed by the secret of the sink, "X-Bridge-Signature: t=<unix time>,v1=<hex of hmac-sha256("<unix time>.<body>")>"
+# NOTE: delivered at least once, the receiver should dedupe by the "X-Bridge-Delivery" header
+# the failed delivery is retried after the backoff (milisec), doubled for each attempt
+initial-backoff = 1000
+max-backoff = 600000
+# the delivery is dropped after the attempts, 0 retries forever
+max-attempts = 0
+
+# the sinks, the empty "types" ("erc20", "nft") and "stages" ("detected", "sent", "retried", "succeeded", "failed", "paused") accept all
+# [[webhook.sinks]]
+# name = "frontend"
+# url = "https://example.com/bridge/webhook"
+# secret = "XXXX.."
+# types = []
+# stages = ["succeeded", "failed"]
+
+###############################################################################
+###                       Health Check Configuration                        ###
+###############################################################################
+[health]
+# "/healthz" fails when no log fetch succeeds within this duration (milisec)
+max-fetch-staleness = 300000
+# "/readyz" fails when the confirmed block lags behind the in chain head more than this blocks
+max-cursor-lag = 1000
+# "/readyz" fails when an event waits for the mint confirmation longer than this duration (milisec)
+max-inflight-age = 600000
+# "/readyz" fails when the confirmer queue is longer than this
+max-confirmer-queue = 1024
 `
 
 var configTemplate *template.Template
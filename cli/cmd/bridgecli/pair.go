@@ -1,24 +1,39 @@
 package main
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
-	"github.com/tak1827/evm-bridge/cli/pb"
-	"github.com/tak1827/go-store/store"
+	"github.com/spf13/viper"
+	b "github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/client"
+)
+
+const (
+	CHECK_TIMEOUT = 60 * time.Second
 )
 
 var (
-	IsWrapped bool
+	IsWrapped  bool
+	SkipChecks bool
+	HexBridge  string
+	URIFrom    string
+	URITo      string
 )
 
 var pairCmd = &cobra.Command{
 	Use:                        "pair",
-	Short:                      "set and get contract pair address",
+	Short:                      "manage contract pair address",
 	DisableFlagParsing:         true,
 	SuggestionsMinimumDistance: 2,
 }
@@ -36,27 +51,15 @@ var pairSetCmd = &cobra.Command{
 		outAddr, err := cast.ToStringE(args[1])
 		handleErr(err)
 
-		if !common.IsHexAddress(inAddr) {
-			handleErr(errors.New(fmt.Sprintf("invalid address formt in-addr: %s", inAddr)))
-		}
-		if !common.IsHexAddress(outAddr) {
-			handleErr(errors.New(fmt.Sprintf("invalid address formt out-addr: %s", outAddr)))
-		}
-
-		pair := pb.Pair{
-			Inaddr:  common.HexToAddress(inAddr).Hex(),
-			Outaddr: common.HexToAddress(outAddr).Hex(),
-			Intype:  pb.Pair_ORIGINAL,
-		}
+		spec := b.PairSpec{Inaddr: inAddr, Outaddr: outAddr, Wrapped: IsWrapped, URIFrom: URIFrom, URITo: URITo}
 
-		if IsWrapped {
-			pair.Intype = pb.Pair_WRAPPED
-		}
+		ctx, cancel := context.WithTimeout(context.Background(), CHECK_TIMEOUT)
+		defer cancel()
 
-		db, err := store.NewLevelDB(homeDir)
-		handleErr(err)
+		repo := openRepo()
+		defer repo.Close()
 
-		err = pair.Put(db)
+		_, err = b.SetPair(ctx, repo, spec, pairChecker(ctx))
 		handleErr(err)
 
 		fmt.Println("succeeded!")
@@ -74,23 +77,186 @@ var pairGetCmd = &cobra.Command{
 		inAddr, err := cast.ToStringE(args[0])
 		handleErr(err)
 
-		if !common.IsHexAddress(inAddr) {
-			handleErr(errors.New(fmt.Sprintf("invalid address formt in-addr: %s", inAddr)))
+		repo := openRepo()
+		defer repo.Close()
+
+		pair, err := b.GetPair(repo, inAddr)
+		handleErr(err)
+
+		spew.Dump(pair)
+	},
+}
+
+var pairListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list contract address pairs",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		repo := openRepo()
+		defer repo.Close()
+
+		pairs, err := repo.ListPairs()
+		handleErr(err)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "IN-ADDR\tOUT-ADDR\tIN-TYPE\tSTATE\tUPDATED-AT")
+		for _, p := range pairs {
+			state, updatedAt := "enabled", "-"
+			if p.Disabled {
+				state = "disabled"
+			}
+			if p.UpdatedAt != nil {
+				updatedAt = p.UpdatedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", p.Inaddr, p.Outaddr, p.Intype, state, updatedAt)
 		}
+		w.Flush()
+	},
+}
+
+var pairDeleteCmd = &cobra.Command{
+	Use:   "delete [in-addr]",
+	Short: "delete contract address pair",
+	Long:  `Delete the pair, the deposits of the token are no longer bridged. use "disable" to keep the config`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
+
+		repo := openRepo()
+		defer repo.Close()
+
+		handleErr(b.DeletePair(repo, args[0]))
+
+		fmt.Println("succeeded!")
+	},
+}
+
+var pairDisableCmd = &cobra.Command{
+	Use:   "disable [in-addr]",
+	Short: "pause bridging of the pair",
+	Long:  `Pause the pair keeping its config. the deposits while disabled are stored as "PAUSED", retry them after enabled`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setPairDisabled(args[0], true)
+	},
+}
+
+var pairEnableCmd = &cobra.Command{
+	Use:   "enable [in-addr]",
+	Short: "resume bridging of the pair",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setPairDisabled(args[0], false)
+	},
+}
+
+var pairImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "register contract address pairs from the file",
+	Long: `Register the pairs listed in the toml or json file at once. nothing is registerd when any of them is invalid.
+e.g.
+[[pairs]]
+inaddr = "0x2518a5D597F670F21Dd4eE989698E18127B3a065"
+outaddr = "0x61221d7b7978F45A1b51af5492a02Ae6Fc199320"
+wrapped = false
+disabled = false
+# optional, the nft token uri "ipfs://.." is minted as "https://ipfs.io/ipfs/.."
+uri_from = "ipfs://"
+uri_to = "https://ipfs.io/ipfs/"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		getConfig()
 
-		db, err := store.NewLevelDB(homeDir)
+		specs, err := readPairSpecs(args[0])
 		handleErr(err)
 
-		pair, err := pb.GetPair(db, common.HexToAddress(inAddr).Hex())
+		ctx, cancel := context.WithTimeout(context.Background(), CHECK_TIMEOUT)
+		defer cancel()
+
+		repo := openRepo()
+		defer repo.Close()
+
+		pairs, err := b.ImportPairs(ctx, repo, specs, pairChecker(ctx))
 		handleErr(err)
 
-		spew.Dump(pair)
+		fmt.Printf("succeeded! %d pairs registerd\n", len(pairs))
 	},
 }
 
+func setPairDisabled(inAddr string, disabled bool) {
+	getConfig()
+
+	repo := openRepo()
+	defer repo.Close()
+
+	_, err := b.SetPairDisabled(repo, inAddr, disabled)
+	handleErr(err)
+
+	fmt.Println("succeeded!")
+}
+
+// pairChecker returns the checker of the pairs against the chains, exits when it is not configured
+func pairChecker(ctx context.Context) *b.PairChecker {
+	if SkipChecks {
+		logger.Warn().Msg("the on-chain checks are skipped")
+		return b.SkipPairChecks
+	}
+
+	getConfigString("in-endpoint", &InEndpoint)
+	getConfigString("out-endpoint", &OutEndpoint)
+	getBanks()
+	getBridge()
+	if HexBridge == "" {
+		logger.Fatal().Msg("please set `bridge`, the contract whitelisting the pairs, or `--skip-checks`")
+	}
+	if PrivKey = viper.GetString("pri_key"); PrivKey == "" {
+		logger.Fatal().Msg("please set `BRIDGECLI_PRI_KEY` as the env variable, or `--skip-checks`")
+	}
+
+	priv, err := crypto.HexToECDSA(PrivKey)
+	handleErr(err)
+
+	c, err := client.NewClient(ctx, OutEndpoint, HexBanks[0])
+	handleErr(err)
+	rc, err := client.NewReadClient(ctx, InEndpoint, HexBanks[0])
+	handleErr(err)
+
+	return b.NewPairChecker(&rc, &c, common.HexToAddress(HexBridge), crypto.PubkeyToAddress(priv.PublicKey))
+}
+
+// readPairSpecs reads the `pairs` list of the file, the format is detected by the extension
+func readPairSpecs(path string) (specs []b.PairSpec, err error) {
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+	if ext != "toml" && ext != "json" {
+		err = fmt.Errorf("unsupported file type(%s), expected toml or json", ext)
+		return
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType(ext)
+	if err = v.ReadInConfig(); err != nil {
+		return
+	}
+
+	err = v.UnmarshalKey("pairs", &specs)
+	return
+}
+
 func init() {
 	pairSetCmd.Flags().BoolVar(&IsWrapped, "in-type-wrapped", false, "the type of in chain contract (`ORIGINAL` or `WRAPPED`) is `WRAPPED`")
+	pairSetCmd.Flags().BoolVar(&SkipChecks, "skip-checks", false, "register without validating the addresses against the chains")
+	pairSetCmd.Flags().StringVar(&URIFrom, "uri-from", "", "the prefix of the nft token uri rewritten on the mint, e.g. `ipfs://`")
+	pairSetCmd.Flags().StringVar(&URITo, "uri-to", "", "the prefix replacing \"uri-from\", e.g. `https://ipfs.io/ipfs/`")
+	pairImportCmd.Flags().BoolVar(&SkipChecks, "skip-checks", false, "register without validating the addresses against the chains")
 	pairCmd.AddCommand(pairSetCmd)
 	pairCmd.AddCommand(pairGetCmd)
+	pairCmd.AddCommand(pairListCmd)
+	pairCmd.AddCommand(pairDeleteCmd)
+	pairCmd.AddCommand(pairDisableCmd)
+	pairCmd.AddCommand(pairEnableCmd)
+	pairCmd.AddCommand(pairImportCmd)
 	rootCmd.AddCommand(pairCmd)
 }
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	b "github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+const (
+	REPLAY_CLOSE_RETRIES = 60 // waits 60s for the mints confirmed
+)
+
+var (
+	ReplayType string
+	ReplayFrom uint64
+	ReplayTo   uint64
+)
+
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "re-fetch the deposits in the block range, then mint the ones not succeeded",
+	Long: `Re-fetch the logs of the banks from "--from" to "--to" block, including the both ends. the succeeded deposits are skipped,
+and the rest are minted same as serving. the cursors are left as is, stop serving before.
+"--to" is the latest block when omitted`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		getServeConfig()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		c, err := client.NewClient(ctx, OutEndpoint, HexBanks[0])
+		handleErr(err)
+
+		rc, banksOpt := readClients(ctx)
+
+		if !cmd.Flags().Changed("to") {
+			ReplayTo, err = rc.LatestBlockNumber(ctx)
+			handleErr(err)
+		}
+
+		confirmer := confirm.NewConfirmer(&c, QueueSize, confirmerOps()...)
+
+		bridge, err := b.NewBridge(ctx, &c, &rc, &confirmer, PrivKey, openDB(), append(retryPolicyOpts(), banksOpt, nonceSyncOpt(), balanceOpt())...)
+		handleErr(err)
+
+		err = bridge.Start(ctx)
+		handleErr(err)
+
+		err = bridge.Replay(ctx, ReplayType, ReplayFrom, ReplayTo)
+		// the sent ones are waited for, even when failed halfway
+		bridge.Close(cancel, REPLAY_CLOSE_RETRIES, false)
+		handleErr(err)
+
+		fmt.Printf("succeeded! replayed %s, from: %d, to: %d\n", ReplayType, ReplayFrom, ReplayTo)
+	},
+}
+
+func init() {
+	replayCmd.Flags().StringVarP(&InEndpoint, "in-endpoint", "i", "http://localhost:8545", "in chain endpoint")
+	replayCmd.Flags().StringVarP(&OutEndpoint, "out-endpoint", "o", "http://localhost:8545", "out chain endpoint")
+	replayCmd.Flags().StringSliceVar(&HexBanks, "bank", nil, "the bank contract addresses, all the configured ones when omitted")
+	replayCmd.Flags().StringVar(&ReplayType, "type", "", "the type of the deposits, erc20 or nft")
+	replayCmd.Flags().Uint64Var(&ReplayFrom, "from", 0, "the first block")
+	replayCmd.Flags().Uint64Var(&ReplayTo, "to", 0, "the last block, the latest when omitted")
+	handleErr(replayCmd.MarkFlagRequired("type"))
+	handleErr(replayCmd.MarkFlagRequired("from"))
+	rootCmd.AddCommand(replayCmd)
+}
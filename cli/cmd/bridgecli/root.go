@@ -2,11 +2,16 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tak1827/evm-bridge/cli/db"
 	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/go-store/store"
 )
 
 const (
@@ -48,6 +53,12 @@ func init() {
 	// will be global for your application.
 
 	rootCmd.PersistentFlags().StringVar(&homeDir, "home", "", fmt.Sprintf("the home directory path (default is $HOME/%s/)", DefaultHomeName))
+	rootCmd.PersistentFlags().String("log-level", "", "the log level, overrides `log.level`")
+	rootCmd.PersistentFlags().String("log-format", "", "the log format, console or json, overrides `log.format`")
+	rootCmd.PersistentFlags().String("log-file", "", "the file to write the logs, overrides `log.file`")
+	viper.BindPFlag("log.level", rootCmd.PersistentFlags().Lookup("log-level"))
+	viper.BindPFlag("log.format", rootCmd.PersistentFlags().Lookup("log-format"))
+	viper.BindPFlag("log.file", rootCmd.PersistentFlags().Lookup("log-file"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -83,6 +94,61 @@ func getConfig() {
 	if err := viper.ReadInConfig(); err == nil {
 		// fmt.Printf("using config file: %s\n", viper.ConfigFileUsed())
 	}
+
+	handleErr(setupLog())
+	setupAuditKey()
+}
+
+// setupLog applies the log settings, the loggers created before keep the old levels
+func setupLog() error {
+	if s := viper.GetString("log.level"); s != "" {
+		lv, err := log.ParseLevel(s)
+		if err != nil {
+			return fmt.Errorf("invalid `log.level`: %w", err)
+		}
+		log.SetLevel(lv)
+	}
+
+	for module, s := range viper.GetStringMapString("log.modules") {
+		if !isLogModule(module) {
+			return fmt.Errorf("unknown log module(%s), expected one of %v", module, log.Modules)
+		}
+		lv, err := log.ParseLevel(s)
+		if err != nil {
+			return fmt.Errorf("invalid `log.modules.%s`: %w", module, err)
+		}
+		log.SetModuleLevel(module, lv)
+	}
+
+	var out io.Writer = os.Stdout
+	if path := viper.GetString("log.file"); path != "" {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(homeDir, path)
+		}
+		maxSize := int64(viper.GetInt("log.max-size")) * 1024 * 1024
+		fw, err := log.NewFileWriter(path, maxSize, viper.GetInt("log.max-backups"))
+		if err != nil {
+			return err
+		}
+		out = fw
+	}
+	w, err := log.FormatWriter(viper.GetString("log.format"), out)
+	if err != nil {
+		return err
+	}
+	log.SetWriter(w)
+
+	logger = log.CLI("")
+	return nil
+}
+
+func isLogModule(module string) bool {
+	for _, m := range log.Modules {
+		if m == module {
+			return true
+		}
+	}
+	return false
 }
 
 func getConfigString(key string, val *string) {
@@ -102,3 +168,15 @@ func getConfigInt(key string, val *int) {
 	}
 	logger.Info().Msgf("%s: %d", key, *val)
 }
+
+// openDB opens the store of the configured backend under the home directory
+func openDB() store.Store {
+	backend := viper.GetString("db.backend")
+	s, err := db.Open(backend, homeDir)
+	handleErr(err)
+	return s
+}
+
+func openRepo() *pb.Repository {
+	return pb.NewRepository(openDB())
+}
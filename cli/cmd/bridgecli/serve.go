@@ -1,40 +1,58 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"math/big"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tak1827/evm-bridge/cli/api"
+	"github.com/tak1827/evm-bridge/cli/backup"
 	b "github.com/tak1827/evm-bridge/cli/bridge"
 	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/db"
 	"github.com/tak1827/evm-bridge/cli/log"
 	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/evm-bridge/cli/service"
+	"github.com/tak1827/evm-bridge/cli/webhook"
+	"github.com/tak1827/go-store/store"
 	"github.com/tak1827/transaction-confirmer/confirm"
 )
 
 const (
 	QueueSize              = 65536
-	MIN_LOG_FETCH_INTERVAL = 3000 // 3s
+	MIN_LOG_FETCH_INTERVAL = 3000  // 3s
+	METRICS_INTERVAL       = 15000 // 15s
 )
 
 var (
 	InEndpoint  string
 	OutEndpoint string
-	HexBank     string
+	HexBanks    []string
 
 	PrivKey string
 
 	LogFetchInterval int
+
+	APIAddress  string
+	APIToken    string
+	GRPCAddress string
+
+	ServeDryRun bool
 )
 
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "serve briging functions",
-	Long:  `Start fetching cotract events, and mint equivalent asset to the other chain`,
+	Long: `Start fetching cotract events, and mint equivalent asset to the other chain.
+With "--dry-run", the mint txs are built, estimated and logged, but never sent. the db is copied in memory,
+so that the cursors and the statuses are not advanced`,
 	Run: func(cmd *cobra.Command, args []string) {
 		getServeConfig()
 		start()
@@ -44,7 +62,10 @@ var serveCmd = &cobra.Command{
 func init() {
 	serveCmd.Flags().StringVarP(&InEndpoint, "in-endpoint", "i", "http://localhost:8545", "in chain endpoint")
 	serveCmd.Flags().StringVarP(&OutEndpoint, "out-endpoint", "o", "http://localhost:8545", "out chain endpoint")
-	serveCmd.Flags().StringVar(&HexBank, "bank", "", "the bank contract address")
+	serveCmd.Flags().StringSliceVar(&HexBanks, "bank", nil, "the bank contract addresses, repeat or separate by comma to watch the multiple banks")
+	serveCmd.Flags().StringVar(&APIAddress, "api-address", "", "the listen address of the status and admin http api")
+	serveCmd.Flags().StringVar(&GRPCAddress, "grpc-address", "", "the listen address of the grpc server")
+	serveCmd.Flags().BoolVar(&ServeDryRun, "dry-run", false, "build and log the mint txs without sending them")
 	rootCmd.AddCommand(serveCmd)
 }
 
@@ -57,11 +78,72 @@ func getServeConfig() {
 
 	getConfigString("in-endpoint", &InEndpoint)
 	getConfigString("out-endpoint", &OutEndpoint)
-	getConfigString("bank", &HexBank)
+	getBanks()
 	getConfigInt("log-fetch-interval", &LogFetchInterval)
 	if LogFetchInterval < MIN_LOG_FETCH_INTERVAL {
 		logger.Fatal().Msgf("`log-fetch-interval` is %d milisec, please set grater than %d milisic", LogFetchInterval, MIN_LOG_FETCH_INTERVAL)
 	}
+
+	if APIAddress == "" {
+		APIAddress = viper.GetString("api.address")
+	}
+	if APIAddress != "" {
+		logger.Info().Msgf("api.address: %s", APIAddress)
+	}
+	if GRPCAddress == "" {
+		GRPCAddress = viper.GetString("grpc.address")
+	}
+	if GRPCAddress != "" {
+		logger.Info().Msgf("grpc.address: %s", GRPCAddress)
+	}
+	getBridge()
+	if APIToken = viper.GetString("api_token"); (APIAddress != "" || GRPCAddress != "") && APIToken == "" {
+		logger.Warn().Msg("the admin api is disabled, set `BRIDGECLI_API_TOKEN` as the env variable to enable")
+	}
+	if viper.GetString("audit_key") == "" {
+		logger.Warn().Msg("the audit log is chained without the key, set `BRIDGECLI_AUDIT_KEY` as the env variable to detect the log rewritten as a whole")
+	}
+}
+
+// getBridge reads the optional `bridge`, the address of the bridge contract
+func getBridge() {
+	if HexBridge = viper.GetString("bridge"); HexBridge == "" {
+		return
+	}
+	if !common.IsHexAddress(HexBridge) {
+		logger.Fatal().Msgf("invalid bridge address(%s)", HexBridge)
+	}
+	logger.Info().Msgf("bridge: %s", HexBridge)
+}
+
+// getBanks reads the `bank`, the single address or the list of them
+func getBanks() {
+	if len(HexBanks) == 0 {
+		HexBanks = viper.GetStringSlice("bank")
+	}
+	if len(HexBanks) == 0 {
+		logger.Fatal().Msg("no `bank` setting")
+	}
+	for _, bank := range HexBanks {
+		if !common.IsHexAddress(bank) {
+			logger.Fatal().Msgf("invalid bank address(%s)", bank)
+		}
+	}
+	logger.Info().Msgf("bank: %v", HexBanks)
+}
+
+// readClients returns the read client of the first bank, and the option watching the rest on the same connection
+func readClients(ctx context.Context) (client.ReadClient, b.Option) {
+	rc, err := client.NewReadClient(ctx, InEndpoint, HexBanks[0])
+	handleErr(err)
+
+	others := make([]*client.ReadClient, len(HexBanks)-1)
+	for i, bank := range HexBanks[1:] {
+		other, err := rc.ForBank(bank)
+		handleErr(err)
+		others[i] = &other
+	}
+	return rc, b.WithBanks(others...)
 }
 
 func confirmerOps() (ops []confirm.Opt) {
@@ -83,63 +165,236 @@ func confirmerOps() (ops []confirm.Opt) {
 	return
 }
 
-func start() {
-	var (
-		ctx, cancel = context.WithCancel(context.Background())
-		rotator     = b.NewRotator(2)
-	)
+func healthThresholds() (th b.HealthThresholds) {
+	th = b.DefaultHealthThresholds
+	if v := viper.GetInt("health.max-fetch-staleness"); v != 0 {
+		th.MaxFetchStaleness = time.Duration(v) * time.Millisecond
+	}
+	if v := viper.GetInt("health.max-cursor-lag"); v != 0 {
+		th.MaxCursorLag = uint64(v)
+	}
+	if v := viper.GetInt("health.max-inflight-age"); v != 0 {
+		th.MaxInflightAge = time.Duration(v) * time.Millisecond
+	}
+	if v := viper.GetInt("health.max-confirmer-queue"); v != 0 {
+		th.MaxConfirmerQueue = v
+	}
+	logger.Info().Msgf("health: %+v", th)
+	return
+}
+
+// retryPolicyOpts reads the `[retry.erc20]` and `[retry.nft]` sections, the unset keys are defaulted
+func retryPolicyOpts() (opts []b.Option) {
+	for _, typ := range []string{pb.EventTypeERC20, pb.EventTypeNFT} {
+		var (
+			p      = b.DefaultRetryPolicy
+			prefix = "retry." + typ + "."
+		)
+		if viper.IsSet(prefix + "max-retries") {
+			p.MaxRetries = uint32(viper.GetInt(prefix + "max-retries"))
+		}
+		if v := viper.GetInt(prefix + "initial-backoff"); v != 0 {
+			p.InitialBackoff = time.Duration(v) * time.Millisecond
+		}
+		if v := viper.GetInt(prefix + "max-backoff"); v != 0 {
+			p.MaxBackoff = time.Duration(v) * time.Millisecond
+		}
+		if v := viper.GetFloat64(prefix + "multiplier"); v != 0 {
+			p.Multiplier = v
+		}
+		if viper.IsSet(prefix + "retryable-errors") {
+			p.Retryable = viper.GetStringSlice(prefix + "retryable-errors")
+		}
+		handleErr(p.Validate())
+		logger.Info().Msgf("retry.%s: %+v", typ, p)
+		opts = append(opts, b.WithRetryPolicy(typ, p))
+	}
+	return
+}
+
+// nonceSyncOpt reads the `[nonce]` section, the unset keys are defaulted
+func nonceSyncOpt() b.Option {
+	p := b.DefaultNonceSyncPolicy
+	if viper.IsSet("nonce.sync-interval") {
+		p.Interval = time.Duration(viper.GetInt("nonce.sync-interval")) * time.Millisecond
+	}
+	if viper.IsSet("nonce.stuck-timeout") {
+		p.StuckTimeout = time.Duration(viper.GetInt("nonce.stuck-timeout")) * time.Millisecond
+	}
+	if viper.IsSet("nonce.gas-bump") {
+		p.GasBump = uint64(viper.GetInt("nonce.gas-bump"))
+	}
+	handleErr(p.Validate())
+	logger.Info().Msgf("nonce: %+v", p)
+	return b.WithNonceSyncPolicy(p)
+}
+
+// balanceOpt reads the `[balance]` section, the thresholds are the wei in decimal string
+func balanceOpt() b.Option {
+	p := b.DefaultBalancePolicy
+	if v := viper.GetInt("balance.check-interval"); v != 0 {
+		p.Interval = time.Duration(v) * time.Millisecond
+	}
+	p.WarnBelow = getWei("balance.warn-below")
+	p.PauseBelow = getWei("balance.pause-below")
+	handleErr(p.Validate())
+	logger.Info().Msgf("balance: %+v", p)
+	return b.WithBalancePolicy(p)
+}
+
+// getWei returns nil when the key is empty
+func getWei(key string) *big.Int {
+	s := viper.GetString(key)
+	if s == "" {
+		return nil
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 {
+		logger.Fatal().Msgf("invalid %s(%s), expected wei in decimal", key, s)
+	}
+	return v
+}
+
+// webhookDispatcher reads the `[webhook]` section, nil when no sink is set
+func webhookDispatcher(bridge *b.Bridge) *webhook.Dispatcher {
+	var sinks []webhook.Sink
+	handleErr(viper.UnmarshalKey("webhook.sinks", &sinks))
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	p := webhook.DefaultPolicy
+	if viper.IsSet("webhook.max-attempts") {
+		p.MaxAttempts = uint32(viper.GetInt("webhook.max-attempts"))
+	}
+	if v := viper.GetInt("webhook.initial-backoff"); v != 0 {
+		p.InitialBackoff = time.Duration(v) * time.Millisecond
+	}
+	if v := viper.GetInt("webhook.max-backoff"); v != 0 {
+		p.MaxBackoff = time.Duration(v) * time.Millisecond
+	}
 
-	c, err := client.NewClient(ctx, OutEndpoint, HexBank)
+	d, err := webhook.NewDispatcher(bridge.Repo, sinks, webhook.WithPolicy(p))
 	handleErr(err)
+	for _, s := range sinks {
+		logger.Info().Msgf("webhook sink: %s, url: %s, types: %v, stages: %v", s.Name, s.URL, s.Types, s.Stages)
+	}
+	return d
+}
+
+func start() {
+	ctx, cancel := context.WithCancel(context.Background())
 
-	rc, err := client.NewReadClient(ctx, InEndpoint, HexBank)
+	c, err := client.NewClient(ctx, OutEndpoint, HexBanks[0])
 	handleErr(err)
 
+	rc, banksOpt := readClients(ctx)
+
 	confirmer := confirm.NewConfirmer(&c, QueueSize, confirmerOps()...)
 
-	bridge, err := b.NewBridge(ctx, &c, &rc, &confirmer, PrivKey, homeDir)
+	s := openDB()
+	if ServeDryRun {
+		s = dryRunDB(s)
+	}
+
+	opts := append(retryPolicyOpts(), banksOpt, nonceSyncOpt(), balanceOpt(), b.WithDryRun(ServeDryRun))
+	// the pairs set by the apis are checked against it
+	if HexBridge != "" {
+		opts = append(opts, b.WithBridgeContract(common.HexToAddress(HexBridge)))
+	}
+	if blocks := viper.GetInt("confirmer.confirmation-blocks"); blocks != 0 {
+		opts = append(opts, b.WithConfirmationBlocks(uint64(blocks)))
+	}
+
+	bridge, err := b.NewBridge(ctx, &c, &rc, &confirmer, PrivKey, s, opts...)
 	handleErr(err)
 
+	// subscribed before started, so that no notification is missed.
+	// the dry run posts nothing
+	var dispatcher *webhook.Dispatcher
+	if !ServeDryRun {
+		dispatcher = webhookDispatcher(bridge)
+	}
+	// stopped apart from the bridge, so that no delivery runs while the db is closed
+	dispatchCtx, stopDispatch := context.WithCancel(ctx)
+	defer stopDispatch()
+	if dispatcher != nil {
+		bridge.Subscribe(dispatcher.Enqueue)
+		dispatcher.Start(dispatchCtx)
+	}
+
 	err = bridge.Start(ctx)
 	handleErr(err)
 
+	var server *api.Server
+	if APIAddress != "" {
+		server = api.NewServer(APIAddress, bridge, api.WithToken(APIToken), api.WithHealthThresholds(healthThresholds()))
+		err = server.Start()
+		handleErr(err)
+	}
+
+	var grpcServer *service.Server
+	if GRPCAddress != "" {
+		grpcServer = service.NewServer(GRPCAddress, bridge, service.WithToken(APIToken))
+		err = grpcServer.Start()
+		handleErr(err)
+	}
+
+	// the pipelines stop before the bridge is closed, the in-flight txs are confirmed meanwhile
+	stopFetch := make(chan struct{})
+	bridge.Run(ctx, stopFetch, time.Duration(LogFetchInterval)*time.Millisecond)
+
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGKILL, syscall.SIGTERM, syscall.SIGINT, os.Interrupt)
 
-	timer := time.NewTicker(time.Duration(int64(LogFetchInterval)/2) * time.Millisecond)
-	defer timer.Stop()
+	metricsTimer := time.NewTicker(METRICS_INTERVAL * time.Millisecond)
+	defer metricsTimer.Stop()
 
 	for {
 		select {
 		case <-sigCh:
 			log.Logger.Info().Msg("shutting down...")
+			close(stopFetch)
+			bridge.Wait()
+			if ServeDryRun {
+				sum := bridge.DryRunSummary()
+				logger.Info().Msgf("dry run, mints not sent, erc20: %d, nft: %d, estimated fee: %s wei", sum.Counts[pb.EventTypeERC20], sum.Counts[pb.EventTypeNFT], sum.Fee)
+			}
+			if server != nil {
+				if err := server.Close(ctx); err != nil {
+					logger.Warn().Err(err).Msg("failed to close api server")
+				}
+			}
+			if grpcServer != nil {
+				grpcServer.Close()
+			}
+			// the undelivered ones, and the ones notified while closing, are left in the outbox for the next run
+			stopDispatch()
+			if dispatcher != nil {
+				dispatcher.Wait()
+			}
+			// the db is closed last
 			bridge.Close(cancel, 3, true)
 			return
-		case <-timer.C:
-			switch rotator.Rotate() {
-			case b.SlotERC20:
-				if !(len(bridge.EventMapERC20) == 0) {
-					continue
-				}
-
-				err = bridge.ConfirmedBlockERC20.Put(bridge.DB, pb.BlockERC20)
-				handleErr(err)
-
-				bridge.ConfirmedBlockERC20.Number, err = bridge.FetchERC20(ctx)
-				handleErr(err)
-
-			case b.SlotNFT:
-				if !(len(bridge.EventMapNFT) == 0) {
-					continue
-				}
-
-				bridge.ConfirmedBlockNFT.Put(bridge.DB, pb.BlockNFT)
-				handleErr(err)
-
-				bridge.ConfirmedBlockNFT.Number, err = bridge.FetchNFT(ctx)
-				handleErr(err)
+		case <-metricsTimer.C:
+			if err := bridge.CollectMetrics(ctx); err != nil {
+				logger.Warn().Err(err).Msg("failed to collect metrics")
 			}
-		default:
 		}
 	}
 }
+
+// dryRunDB copies the db in memory, then closes it. the writes of the dry run are discarded on exit
+func dryRunDB(s store.Store) store.Store {
+	var buf bytes.Buffer
+	sum, err := backup.Export(pb.NewRepository(s), &buf)
+	handleErr(err)
+	handleErr(s.Close())
+
+	mem := db.NewMemDB()
+	_, err = backup.Import(pb.NewRepository(mem), &buf)
+	handleErr(err)
+
+	logger.Warn().Msgf("dry run, the mint txs are not sent, and the db is copied in memory, %s", sum)
+	return mem
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/tak1827/go-store/store"
+)
+
+const (
+	BackendLevelDB = "leveldb"
+	BackendMemory  = "memory"
+	BackendSQLite  = "sqlite"
+)
+
+var (
+	ErrIterationUnsupported = errors.New("iteration is not supported by the store")
+	ErrUnknownBackend       = errors.New("unknown db backend")
+)
+
+// Iterator is implemented by stores which can walk over the keys sharing a prefix in ascending order.
+// the key and value passed to fn are only valid during the call
+type Iterator interface {
+	Iterate(prefix []byte, fn func(key, value []byte) error) error
+}
+
+// Iterate calls fn for each key under the prefix, the key passed to fn has the prefix stripped
+func Iterate(s store.Store, prefix []byte, fn func(key, value []byte) error) error {
+	it, ok := s.(Iterator)
+	if !ok {
+		return ErrIterationUnsupported
+	}
+	return it.Iterate(prefix, fn)
+}
+
+// Open opens the store of the backend under the home directory.
+// the leveldb files are placed directly under the home for the compatibility
+func Open(backend, home string) (store.Store, error) {
+	switch backend {
+	case BackendLevelDB, "":
+		return NewLevelDB(home)
+	case BackendMemory:
+		return NewMemDB(), nil
+	case BackendSQLite:
+		if home == "" {
+			return NewSQLite("")
+		}
+		return NewSQLite(filepath.Join(home, SQLiteFileName))
+	default:
+		return nil, fmt.Errorf("%w: %s, expected %s, %s or %s", ErrUnknownBackend, backend, BackendLevelDB, BackendMemory, BackendSQLite)
+	}
+}
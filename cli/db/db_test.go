@@ -0,0 +1,73 @@
+package db
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/go-store/store"
+)
+
+func TestBackends(t *testing.T) {
+	level, err := NewLevelDB("")
+	require.NoError(t, err)
+
+	sqlite, err := NewSQLite(filepath.Join(t.TempDir(), SQLiteFileName))
+	require.NoError(t, err)
+
+	backends := map[string]store.Store{
+		BackendLevelDB: level,
+		BackendMemory:  NewMemDB(),
+		BackendSQLite:  sqlite,
+	}
+
+	for name, s := range backends {
+		t.Run(name, func(t *testing.T) {
+			defer s.Close()
+
+			_, err := s.Get([]byte(".a1"))
+			require.ErrorIs(t, err, store.ErrNotFound)
+
+			require.NoError(t, s.Put([]byte(".a2"), []byte("2")))
+			require.NoError(t, s.Put([]byte(".a1"), []byte("1")))
+			require.NoError(t, s.Put([]byte(".b1"), []byte("3")))
+			require.NoError(t, s.Put([]byte(".a1"), []byte("4")))
+
+			v, err := s.Get([]byte(".a1"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("4"), v)
+
+			has, err := s.Has([]byte(".b1"))
+			require.NoError(t, err)
+			require.True(t, has)
+
+			var keys, values []string
+			require.NoError(t, Iterate(s, []byte(".a"), func(key, value []byte) error {
+				keys = append(keys, string(key))
+				values = append(values, string(value))
+				return nil
+			}))
+			require.Equal(t, []string{"1", "2"}, keys)
+			require.Equal(t, []string{"4", "2"}, values)
+
+			require.NoError(t, s.Delete([]byte(".b1")))
+			has, err = s.Has([]byte(".b1"))
+			require.NoError(t, err)
+			require.False(t, has)
+
+			sn, err := Snapshot(s)
+			require.NoError(t, err)
+			defer sn.Close()
+			require.NoError(t, s.Put([]byte(".a1"), []byte("5")))
+			v, err = sn.Get([]byte(".a1"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("4"), v)
+		})
+	}
+}
+
+func TestPrefixLimit(t *testing.T) {
+	require.Equal(t, []byte(".b"), prefixLimit([]byte(".a")))
+	require.Equal(t, []byte{0x01}, prefixLimit([]byte{0x00, 0xff}))
+	require.Nil(t, prefixLimit([]byte{0xff, 0xff}))
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tak1827/go-store/store"
+)
+
+var (
+	_ store.Store = (*LevelDB)(nil)
+	_ Iterator    = (*LevelDB)(nil)
+)
+
+type LevelDB struct {
+	dir string
+	db  *leveldb.DB
+}
+
+// NewLevelDB opens the leveldb under the dir, the in memory storage is used when the dir is empty
+func NewLevelDB(dir string) (*LevelDB, error) {
+	opts := &opt.Options{
+		Filter: filter.NewBloomFilter(10),
+	}
+
+	var (
+		db  *leveldb.DB
+		err error
+	)
+
+	if len(dir) == 0 {
+		db, err = leveldb.Open(storage.NewMemStorage(), opts)
+	} else {
+		db, err = leveldb.OpenFile(dir, opts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to init leveldb: %w", err)
+	}
+
+	return &LevelDB{
+		dir: dir,
+		db:  db,
+	}, nil
+}
+
+func (l *LevelDB) Close() error {
+	return l.db.Close()
+}
+
+func (l *LevelDB) Get(key []byte) ([]byte, error) {
+	v, err := l.db.Get(key, nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, store.ErrNotFound
+		}
+		return nil, fmt.Errorf("faild to get: %w", err)
+	}
+
+	return v, nil
+}
+
+func (l *LevelDB) Put(key, value []byte) error {
+	return l.db.Put(key, value, nil)
+}
+
+func (l *LevelDB) Delete(key []byte) error {
+	return l.db.Delete(key, nil)
+}
+
+func (l *LevelDB) Has(key []byte) (bool, error) {
+	return l.db.Has(key, nil)
+}
+
+func (l *LevelDB) Dir() string {
+	return l.dir
+}
+
+func (l *LevelDB) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	it := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer it.Release()
+
+	for it.Next() {
+		if err := fn(it.Key()[len(prefix):], it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/tak1827/go-store/store"
+)
+
+var (
+	_ store.Store = (*MemDB)(nil)
+	_ Iterator    = (*MemDB)(nil)
+)
+
+// MemDB is the volatile store for tests and dry runs
+type MemDB struct {
+	sync.RWMutex
+
+	kv map[string][]byte
+}
+
+func NewMemDB() *MemDB {
+	return &MemDB{
+		kv: make(map[string][]byte),
+	}
+}
+
+func (m *MemDB) Close() error {
+	return nil
+}
+
+func (m *MemDB) Get(key []byte) ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	v, ok := m.kv[string(key)]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return append([]byte(nil), v...), nil
+}
+
+func (m *MemDB) Put(key, value []byte) error {
+	m.Lock()
+	defer m.Unlock()
+
+	m.kv[string(key)] = append([]byte(nil), value...)
+	return nil
+}
+
+func (m *MemDB) Delete(key []byte) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.kv, string(key))
+	return nil
+}
+
+func (m *MemDB) Has(key []byte) (bool, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	_, ok := m.kv[string(key)]
+	return ok, nil
+}
+
+func (m *MemDB) Dir() string {
+	return ""
+}
+
+func (m *MemDB) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	m.RLock()
+	keys := make([]string, 0)
+	for k := range m.kv {
+		if strings.HasPrefix(k, string(prefix)) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, len(keys))
+	for i, k := range keys {
+		values[i] = m.kv[k]
+	}
+	m.RUnlock()
+
+	// call fn without the lock, so that fn can write the store
+	for i, k := range keys {
+		if err := fn([]byte(k)[len(prefix):], values[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tak1827/go-store/store"
+)
+
+var (
+	ErrReadOnly            = errors.New("the store is read only")
+	ErrSnapshotUnsupported = errors.New("snapshot is not supported by the store")
+
+	_ Snapshotter = (*LevelDB)(nil)
+	_ Snapshotter = (*MemDB)(nil)
+	_ Snapshotter = (*SQLite)(nil)
+
+	_ store.Store = (*levelSnapshot)(nil)
+	_ Iterator    = (*levelSnapshot)(nil)
+)
+
+// Snapshotter is implemented by stores which can take the point in time view of all records.
+// the snapshot is not affected by the later writes, close it after use
+type Snapshotter interface {
+	Snapshot() (store.Store, error)
+}
+
+// Snapshot takes the consistent view of the store, which is safe to read while the store is written
+func Snapshot(s store.Store) (store.Store, error) {
+	sn, ok := s.(Snapshotter)
+	if !ok {
+		return nil, ErrSnapshotUnsupported
+	}
+	return sn.Snapshot()
+}
+
+func (l *LevelDB) Snapshot() (store.Store, error) {
+	sn, err := l.db.GetSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take snapshot: %w", err)
+	}
+	return &levelSnapshot{dir: l.dir, sn: sn}, nil
+}
+
+func (m *MemDB) Snapshot() (store.Store, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	c := NewMemDB()
+	for k, v := range m.kv {
+		c.kv[k] = v
+	}
+	return c, nil
+}
+
+// Snapshot copies all records into memory by the single query, which sees the consistent state
+func (s *SQLite) Snapshot() (store.Store, error) {
+	rows, err := s.db.Query(`SELECT key, value FROM kv`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	c := NewMemDB()
+	for rows.Next() {
+		var key, value []byte
+		if err = rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("failed to take snapshot: %w", err)
+		}
+		c.kv[string(key)] = value
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to take snapshot: %w", err)
+	}
+	return c, nil
+}
+
+type levelSnapshot struct {
+	dir string
+	sn  *leveldb.Snapshot
+}
+
+func (l *levelSnapshot) Close() error {
+	l.sn.Release()
+	return nil
+}
+
+func (l *levelSnapshot) Get(key []byte) ([]byte, error) {
+	v, err := l.sn.Get(key, nil)
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil, store.ErrNotFound
+		}
+		return nil, fmt.Errorf("faild to get: %w", err)
+	}
+	return v, nil
+}
+
+func (l *levelSnapshot) Put(key, value []byte) error {
+	return ErrReadOnly
+}
+
+func (l *levelSnapshot) Delete(key []byte) error {
+	return ErrReadOnly
+}
+
+func (l *levelSnapshot) Has(key []byte) (bool, error) {
+	return l.sn.Has(key, nil)
+}
+
+func (l *levelSnapshot) Dir() string {
+	return l.dir
+}
+
+func (l *levelSnapshot) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	it := l.sn.NewIterator(util.BytesPrefix(prefix), nil)
+	defer it.Release()
+
+	for it.Next() {
+		if err := fn(it.Key()[len(prefix):], it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
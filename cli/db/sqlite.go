@@ -0,0 +1,141 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/tak1827/go-store/store"
+)
+
+const (
+	SQLiteFileName = "bridge.sqlite"
+)
+
+var (
+	_ store.Store = (*SQLite)(nil)
+	_ Iterator    = (*SQLite)(nil)
+)
+
+// SQLite keeps the records in the single `kv` table, so that the history can be queried with sql.
+// e.g. select count(*) from kv where key like '.eventerc20%';
+type SQLite struct {
+	path string
+	db   *sql.DB
+}
+
+// NewSQLite opens the sqlite database file, the in memory database is used when the path is empty
+func NewSQLite(path string) (*SQLite, error) {
+	dsn := ":memory:"
+	if len(path) != 0 {
+		dsn = fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", path)
+	}
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init sqlite: %w", err)
+	}
+	// the writes are serialized by sqlite anyway
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec(`CREATE TABLE IF NOT EXISTS kv (key BLOB PRIMARY KEY, value BLOB NOT NULL)`); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to init sqlite: %w", err)
+	}
+
+	return &SQLite{
+		path: path,
+		db:   db,
+	}, nil
+}
+
+func (s *SQLite) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLite) Get(key []byte) ([]byte, error) {
+	var v []byte
+	if err := s.db.QueryRow(`SELECT value FROM kv WHERE key = ?`, key).Scan(&v); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, fmt.Errorf("faild to get: %w", err)
+	}
+	return v, nil
+}
+
+func (s *SQLite) Put(key, value []byte) error {
+	_, err := s.db.Exec(`INSERT INTO kv (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = excluded.value`, key, value)
+	return err
+}
+
+func (s *SQLite) Delete(key []byte) error {
+	_, err := s.db.Exec(`DELETE FROM kv WHERE key = ?`, key)
+	return err
+}
+
+func (s *SQLite) Has(key []byte) (bool, error) {
+	var n int
+	if err := s.db.QueryRow(`SELECT count(*) FROM kv WHERE key = ?`, key).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (s *SQLite) Dir() string {
+	return s.path
+}
+
+func (s *SQLite) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if limit := prefixLimit(prefix); limit != nil {
+		rows, err = s.db.Query(`SELECT key, value FROM kv WHERE key >= ? AND key < ? ORDER BY key`, prefix, limit)
+	} else {
+		rows, err = s.db.Query(`SELECT key, value FROM kv WHERE key >= ? ORDER BY key`, prefix)
+	}
+	if err != nil {
+		return err
+	}
+
+	// read all rows first, since the single connection is occupied while rows are open
+	type kv struct{ key, value []byte }
+	var records []kv
+	for rows.Next() {
+		var r kv
+		if err = rows.Scan(&r.key, &r.value); err != nil {
+			rows.Close()
+			return err
+		}
+		records = append(records, r)
+	}
+	if err = rows.Close(); err != nil {
+		return err
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if err = fn(r.key[len(prefix):], r.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prefixLimit returns the smallest key greater than all keys with the prefix, nil when there is no limit
+func prefixLimit(prefix []byte) []byte {
+	limit := append([]byte(nil), prefix...)
+	for i := len(limit) - 1; i >= 0; i-- {
+		if limit[i] < 0xff {
+			limit[i]++
+			return limit[:i+1]
+		}
+	}
+	return nil
+}
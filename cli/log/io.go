@@ -1,7 +1,11 @@
 package log
 
 import (
+	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"sync"
 )
 
 type Writer struct {
@@ -15,3 +19,94 @@ func (w *Writer) Write(p []byte) (n int, err error) {
 func (w *Writer) SetWriter(writer io.Writer) {
 	w.Out = writer
 }
+
+// FileWriter appends to the file, the file is rotated when it exceeds the max size.
+// the rotated ones are renamed to `<path>.1`, `<path>.2`, .. and the older than the max backups are removed
+type FileWriter struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileWriter opens the file, the max size of 0 disables the rotation
+func NewFileWriter(path string, maxSize int64, maxBackups int) (*FileWriter, error) {
+	if maxSize < 0 || maxBackups < 0 {
+		return nil, fmt.Errorf("invalid log rotation: max-size(%d) and max-backups(%d) must not be negative", maxSize, maxBackups)
+	}
+
+	w := &FileWriter{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *FileWriter) Write(p []byte) (n int, err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		if err = w.rotate(); err != nil {
+			return
+		}
+	}
+
+	n, err = w.file.Write(p)
+	w.size += int64(n)
+	return
+}
+
+func (w *FileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
+
+func (w *FileWriter) open() error {
+	if err := os.MkdirAll(filepath.Dir(w.path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	w.file, w.size = f, info.Size()
+	return nil
+}
+
+func (w *FileWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	if w.maxBackups == 0 {
+		if err := os.Remove(w.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return w.open()
+	}
+
+	// shift the backups, the oldest is overwritten
+	for i := w.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(w.backup(i), w.backup(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(w.path, w.backup(1)); err != nil {
+		return err
+	}
+	return w.open()
+}
+
+func (w *FileWriter) backup(i int) string {
+	return fmt.Sprintf("%s.%d", w.path, i)
+}
@@ -1,9 +1,12 @@
 package log
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -19,10 +22,18 @@ const (
 	KeyModule = "mod"
 	KeyEvent  = "event"
 
-	ModuleBridge = "bridge"
-	ModuleCLI    = "cli"
+	ModuleBridge  = "bridge"
+	ModuleCLI     = "cli"
+	ModuleAPI     = "api"
+	ModuleGRPC    = "grpc"
+	ModuleWebhook = "webhook"
+
+	FORMAT_CONSOLE = "console"
+	FORMAT_JSON    = "json"
 )
 
+var Modules = []string{ModuleBridge, ModuleCLI, ModuleAPI, ModuleGRPC, ModuleWebhook}
+
 // global
 var Logger = zerolog.New(writer).With().Timestamp().Logger()
 var ConsoleWriter = zerolog.ConsoleWriter{Out: os.Stdout}
@@ -32,6 +43,9 @@ var (
 	defaultLevel      = DEBUG_LEVEL
 	defaultWriter     = ConsoleWriter
 	defaultTimeFormat = time.RFC3339
+
+	mu           sync.RWMutex
+	moduleLevels = make(map[string]zerolog.Level)
 )
 
 // default config
@@ -61,16 +75,69 @@ func SetTimeFormat(format string) {
 	}
 }
 
-func Bridge(event string) zerolog.Logger {
-	if event == "" {
-		return Logger.With().Str(KeyModule, ModuleBridge).Logger()
+// SetModuleLevel overrides the level of the module logger, the loggers created after are affected
+func SetModuleLevel(module string, lv zerolog.Level) {
+	mu.Lock()
+	defer mu.Unlock()
+	moduleLevels[module] = lv
+}
+
+// ParseLevel parses the level name, such as "debug" or "info"
+func ParseLevel(s string) (zerolog.Level, error) {
+	lv, err := zerolog.ParseLevel(strings.ToLower(s))
+	if err != nil {
+		return lv, err
+	}
+	if lv == zerolog.NoLevel {
+		return lv, fmt.Errorf("empty log level")
 	}
-	return Logger.With().Str(KeyModule, ModuleBridge).Str(KeyEvent, event).Logger()
+	return lv, nil
 }
 
-func CLI(event string) zerolog.Logger {
-	if event == "" {
-		return Logger.With().Str(KeyModule, ModuleCLI).Logger()
+// FormatWriter returns the writer of the format, `FORMAT_CONSOLE` or `FORMAT_JSON`
+func FormatWriter(format string, out io.Writer) (io.Writer, error) {
+	switch strings.ToLower(format) {
+	case "", FORMAT_CONSOLE:
+		_, isFile := out.(*FileWriter)
+		return zerolog.ConsoleWriter{Out: out, TimeFormat: zerolog.TimeFieldFormat, NoColor: isFile}, nil
+	case FORMAT_JSON:
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported log format(%s), expected %s or %s", format, FORMAT_CONSOLE, FORMAT_JSON)
+	}
+}
+
+func module(name, event string) zerolog.Logger {
+	ctx := Logger.With().Str(KeyModule, name)
+	if event != "" {
+		ctx = ctx.Str(KeyEvent, event)
+	}
+	l := ctx.Logger()
+
+	mu.RLock()
+	defer mu.RUnlock()
+	if lv, ok := moduleLevels[name]; ok {
+		l = l.Level(lv)
 	}
-	return Logger.With().Str(KeyModule, ModuleCLI).Str(KeyEvent, event).Logger()
+	return l
+}
+
+func Bridge(event string) zerolog.Logger {
+	return module(ModuleBridge, event)
+}
+
+func CLI(event string) zerolog.Logger {
+	return module(ModuleCLI, event)
+}
+
+func API(event string) zerolog.Logger {
+	return module(ModuleAPI, event)
+}
+
+func GRPC(event string) zerolog.Logger {
+	return module(ModuleGRPC, event)
+}
+
+func Webhook(event string) zerolog.Logger {
+	return module(ModuleWebhook, event)
 }
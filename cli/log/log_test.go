@@ -0,0 +1,66 @@
+package log
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileWriterRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "bridge.log")
+
+	w, err := NewFileWriter(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err = w.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	read := func(p string) string {
+		b, err := os.ReadFile(p)
+		require.NoError(t, err)
+		return string(b)
+	}
+	require.Equal(t, "fourth\n", read(path))
+	require.Equal(t, "third\n", read(path+".1"))
+	require.Equal(t, "second\n", read(path+".2"))
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+
+	// continues the size of the existing file
+	w, err = NewFileWriter(path, 10, 2)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("fifth\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, "fifth\n", read(path))
+	require.Equal(t, "fourth\n", read(path+".1"))
+}
+
+func TestModuleLevel(t *testing.T) {
+	var buf bytes.Buffer
+	SetWriter(&buf)
+	SetLevel(INFO_LEVEL)
+	defer func() {
+		SetWriter(ConsoleWriter)
+		SetLevel(defaultLevel)
+		delete(moduleLevels, ModuleBridge)
+	}()
+
+	SetModuleLevel(ModuleBridge, DEBUG_LEVEL)
+	bridge, cli := Bridge(""), CLI("")
+	bridge.Debug().Msg("bridge")
+	cli.Debug().Msg("cli")
+	require.Contains(t, buf.String(), `"message":"bridge"`)
+	require.NotContains(t, buf.String(), `"message":"cli"`)
+
+	_, err := ParseLevel("verbose")
+	require.Error(t, err)
+	_, err = FormatWriter("xml", &buf)
+	require.Error(t, err)
+}
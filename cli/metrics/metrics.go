@@ -0,0 +1,137 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	Namespace = "bridge"
+
+	LabelType   = "type"
+	LabelToken  = "token"
+	LabelChain  = "chain"
+	LabelMethod = "method"
+	LabelBank   = "bank"
+
+	ChainIn  = "in"
+	ChainOut = "out"
+)
+
+var (
+	Registry = prometheus.NewRegistry()
+
+	EventsFetched = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "events_fetched_total",
+		Help:      "The number of deposit events fetched from the in chain",
+	}, []string{LabelType, LabelToken})
+	EventsMinted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "events_minted_total",
+		Help:      "The number of deposit events minted on the out chain",
+	}, []string{LabelType, LabelToken})
+	EventsFailed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "events_failed_total",
+		Help:      "The number of deposit events marked as failed",
+	}, []string{LabelType, LabelToken})
+	EventsRetried = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "events_retried_total",
+		Help:      "The number of mint retries",
+	}, []string{LabelType, LabelToken})
+
+	ConfirmationLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Name:      "deposit_confirmation_seconds",
+		Help:      "The latency from the deposit detection to the mint confirmation",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{LabelType})
+
+	ChainHead = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "chain_head_block",
+		Help:      "The latest block number of the chain",
+	}, []string{LabelChain})
+	ConfirmedBlock = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "confirmed_block",
+		Help:      "The block number the events are fetched up to",
+	}, []string{LabelType, LabelBank})
+	CursorLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "cursor_lag_blocks",
+		Help:      "The number of blocks between the in chain head and the confirmed block",
+	}, []string{LabelType, LabelBank})
+	Inflight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "inflight_events",
+		Help:      "The number of events waiting for the mint confirmation",
+	}, []string{LabelType})
+
+	SignerBalance = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "signer_balance_wei",
+		Help:      "The balance of the signer on the out chain",
+	})
+	MintingPaused = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "minting_paused",
+		Help:      "1 when the minting is paused by the low balance of the signer",
+	})
+	SignerNonce = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Name:      "signer_nonce",
+		Help:      "The next nonce of the signer on the out chain",
+	})
+
+	RPCDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "The duration of the rpc calls",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{LabelChain, LabelMethod})
+	RPCErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "rpc_errors_total",
+		Help:      "The number of failed rpc calls",
+	}, []string{LabelChain, LabelMethod})
+)
+
+func init() {
+	Registry.MustRegister(
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		EventsFetched,
+		EventsMinted,
+		EventsFailed,
+		EventsRetried,
+		ConfirmationLatency,
+		ChainHead,
+		ConfirmedBlock,
+		CursorLag,
+		Inflight,
+		SignerBalance,
+		MintingPaused,
+		SignerNonce,
+		RPCDuration,
+		RPCErrors,
+	)
+}
+
+// Handler serves the registered metrics in the prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRPC records the duration and the failure of the rpc call started at `start`
+func ObserveRPC(chain, method string, start time.Time, err error) {
+	RPCDuration.WithLabelValues(chain, method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		RPCErrors.WithLabelValues(chain, method).Inc()
+	}
+}
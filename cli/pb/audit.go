@@ -0,0 +1,16 @@
+package pb
+
+import (
+	"github.com/lithdew/bytesutil"
+)
+
+var (
+	PREFIX_AUDIT_LOG = []byte(".auditlog")
+
+	// the seq of the last entry, not under PREFIX_AUDIT_LOG
+	KEY_AUDIT_HEAD = []byte(".headauditlog")
+)
+
+func (m *AuditEntry) StoreKey() []byte {
+	return bytesutil.AppendUint64BE(nil, m.GetSeq())
+}
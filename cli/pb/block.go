@@ -1,9 +1,10 @@
 package pb
 
 import (
-	"errors"
+	"bytes"
+	"fmt"
 
-	"github.com/tak1827/go-store/store"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var (
@@ -12,8 +13,6 @@ var (
 	KEY_ERC20 = []byte(".erc20")
 	KEY_COIN  = []byte(".coin") // native coin, like ETH
 	KEY_NFT   = []byte(".nft")
-
-	blockStore *store.PrefixStore
 )
 
 type BlockType int
@@ -23,48 +22,49 @@ const (
 	BlockNFT
 )
 
-func (m *ConfirmedBlock) Get(db store.Store, t BlockType) error {
-	var (
-		s   = getBlockStore(db)
-		v   []byte
-		err error
-	)
+func (t BlockType) StoreKey() []byte {
 	switch t {
 	case BlockERC20:
-		v, err = s.Get(KEY_ERC20)
+		return KEY_ERC20
 	case BlockNFT:
-		v, err = s.Get(KEY_NFT)
-	}
-
-	if err != nil {
-		if errors.Is(err, store.ErrNotFound) {
-			return nil
-		}
-		return err
+		return KEY_NFT
+	default:
+		panic("unexpected block type")
 	}
-	return m.Unmarshal(v)
 }
 
-func (m ConfirmedBlock) Put(db store.Store, t BlockType) error {
-	s := getBlockStore(db)
-	value, err := m.Marshal()
-	if err != nil {
-		return err
-	}
-
+// EventType returns the type of the events fetched from the block
+func (t BlockType) EventType() string {
 	switch t {
 	case BlockERC20:
-		s.Put(KEY_ERC20, value)
+		return EventTypeERC20
 	case BlockNFT:
-		s.Put(KEY_NFT, value)
+		return EventTypeNFT
+	default:
+		panic("unexpected block type")
 	}
+}
 
-	return nil
+// confirmedBlockKey namespaces the block type by the bank, same as the events
+func confirmedBlockKey(bank string, t BlockType) []byte {
+	if bank == "" {
+		return t.StoreKey()
+	}
+	return append(common.HexToAddress(bank).Bytes(), t.StoreKey()...)
 }
 
-func getBlockStore(db store.Store) *store.PrefixStore {
-	if blockStore == nil {
-		blockStore = store.NewPrefixStore(db, PREFIX_CONFIRMED_BLOCK)
+// parseConfirmedBlockKey is the reverse of `confirmedBlockKey`
+func parseConfirmedBlockKey(key []byte) (bank string, t BlockType, err error) {
+	if len(key) > common.AddressLength {
+		bank, key = common.BytesToAddress(key[:common.AddressLength]).Hex(), key[common.AddressLength:]
+	}
+	switch {
+	case bytes.Equal(key, KEY_ERC20):
+		t = BlockERC20
+	case bytes.Equal(key, KEY_NFT):
+		t = BlockNFT
+	default:
+		err = fmt.Errorf("unexpected confirmed block key(%x)", key)
 	}
-	return blockStore
+	return
 }
@@ -1,35 +1,74 @@
 package pb
 
 import (
-	"github.com/lithdew/bytesutil"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/tak1827/evm-bridge/cli/client"
-	"github.com/tak1827/go-store/store"
+)
+
+const (
+	EventTypeERC20 = "erc20"
+	EventTypeNFT   = "nft"
 )
 
 var (
 	PREFIX_EVENT_ERC20 = []byte(".eventerc20")
 	PREFIX_EVENT_NFT   = []byte(".eventnft")
 
-	eventStoreERC20 *store.PrefixStore
-	eventStoreNFT   *store.PrefixStore
-
 	_ Event = (*EventERC20Deposited)(nil)
 	_ Event = (*EventNFTDeposited)(nil)
+
+	ErrInvalidUint256 = errors.New("invalid uint256")
 )
 
 type Event interface {
+	Type() string
+	GetId() string
+	GetBank() string
 	GetRetry() uint32
 	SetRetry(retry uint32)
 	GetStatus() EventStatus
 	SetStatus(status EventStatus)
 	GetToken() string
-	Get(db store.Store) error
-	Put(db store.Store) error
+	GetNextAttemptAt() *time.Time
+	SetNextAttemptAt(at *time.Time)
+	StoreKey() []byte
+	Marshal() ([]byte, error)
+	Unmarshal(b []byte) error
+}
+
+// NewEvent returns the empty event of the type, which can be filled by `Repository.GetEvent`.
+// the id is the uint256 in decimal
+func NewEvent(typ, bank, id string) (Event, error) {
+	n, err := ParseUint256(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w, id: %s", err, id)
+	}
+	id = n.String()
+
+	switch typ {
+	case EventTypeERC20:
+		return &EventERC20Deposited{Id: id, Bank: bank}, nil
+	case EventTypeNFT:
+		return &EventNFTDeposited{Id: id, Bank: bank}, nil
+	default:
+		return nil, fmt.Errorf("unexpected event type(%s), expected %s or %s", typ, EventTypeERC20, EventTypeNFT)
+	}
+}
+
+func (m *EventERC20Deposited) Type() string {
+	return EventTypeERC20
 }
 
 func (m *EventERC20Deposited) StoreKey() []byte {
-	id := m.GetId()
-	return bytesutil.AppendUint64BE(nil, id)
+	return eventStoreKey(m.GetBank(), m.GetId())
 }
 
 func (m *EventERC20Deposited) SetRetry(retry uint32) {
@@ -40,27 +79,14 @@ func (m *EventERC20Deposited) SetStatus(status EventStatus) {
 	m.Status = status
 }
 
-func (m *EventERC20Deposited) Get(db store.Store) error {
-	s := getEventERC20Store(db)
-	v, err := s.Get(m.StoreKey())
-	if err != nil {
-		return err
-	}
-	return m.Unmarshal(v)
-}
-
-func (m *EventERC20Deposited) Put(db store.Store) error {
-	s := getEventERC20Store(db)
-	value, err := m.Marshal()
-	if err != nil {
-		return err
-	}
-	return s.Put(m.StoreKey(), value)
+func (m *EventERC20Deposited) SetNextAttemptAt(at *time.Time) {
+	m.NextAttemptAt = at
 }
 
 func ToEventERC20Deposited(e *client.IBankERC20Deposited) *EventERC20Deposited {
 	return &EventERC20Deposited{
-		Id:     uint64(e.Id.Int64()),
+		Id:     e.Id.String(),
+		Bank:   e.Raw.Address.Hex(),
 		Token:  e.Token.Hex(),
 		Sender: e.Sender.Hex(),
 		Amount: e.Amount.String(),
@@ -69,16 +95,12 @@ func ToEventERC20Deposited(e *client.IBankERC20Deposited) *EventERC20Deposited {
 	}
 }
 
-func getEventERC20Store(db store.Store) *store.PrefixStore {
-	if eventStoreERC20 == nil {
-		eventStoreERC20 = store.NewPrefixStore(db, PREFIX_EVENT_ERC20)
-	}
-	return eventStoreERC20
+func (m *EventNFTDeposited) Type() string {
+	return EventTypeNFT
 }
 
 func (m *EventNFTDeposited) StoreKey() []byte {
-	id := m.GetId()
-	return bytesutil.AppendUint64BE(nil, id)
+	return eventStoreKey(m.GetBank(), m.GetId())
 }
 
 func (m *EventNFTDeposited) SetRetry(retry uint32) {
@@ -89,38 +111,128 @@ func (m *EventNFTDeposited) SetStatus(status EventStatus) {
 	m.Status = status
 }
 
-func (m *EventNFTDeposited) Get(db store.Store) error {
-	s := getEventNFTStore(db)
-	v, err := s.Get(m.StoreKey())
-	if err != nil {
-		return err
-	}
-	return m.Unmarshal(v)
-}
-
-func (m *EventNFTDeposited) Put(db store.Store) error {
-	s := getEventNFTStore(db)
-	value, err := m.Marshal()
-	if err != nil {
-		return err
-	}
-	return s.Put(m.StoreKey(), value)
+func (m *EventNFTDeposited) SetNextAttemptAt(at *time.Time) {
+	m.NextAttemptAt = at
 }
 
 func ToEventNFTDeposited(e *client.IBankNFTDeposited) *EventNFTDeposited {
 	return &EventNFTDeposited{
-		Id:      uint64(e.Id.Int64()),
+		Id:      e.Id.String(),
+		Bank:    e.Raw.Address.Hex(),
 		Token:   e.Token.Hex(),
 		Sender:  e.Sender.Hex(),
-		Tokenid: uint64(e.Tokenid.Int64()),
+		Tokenid: e.Tokenid.String(),
 		Retry:   0,
 		Status:  EventStatus_UNDEFINED,
 	}
 }
 
-func getEventNFTStore(db store.Store) *store.PrefixStore {
-	if eventStoreNFT == nil {
-		eventStoreNFT = store.NewPrefixStore(db, PREFIX_EVENT_NFT)
+// UnmarshalJSON accepts the ids as the numbers, as exported before uint256
+func (m *EventERC20Deposited) UnmarshalJSON(b []byte) error {
+	type alias EventERC20Deposited
+	v := struct {
+		*alias
+		Id json.Number `json:"id,omitempty"`
+	}{alias: (*alias)(m)}
+	if err := unmarshalStrict(b, &v); err != nil {
+		return err
+	}
+	m.Id = v.Id.String()
+	return nil
+}
+
+// UnmarshalJSON accepts the ids as the numbers, as exported before uint256
+func (m *EventNFTDeposited) UnmarshalJSON(b []byte) error {
+	type alias EventNFTDeposited
+	v := struct {
+		*alias
+		Id      json.Number `json:"id,omitempty"`
+		Tokenid json.Number `json:"tokenid,omitempty"`
+	}{alias: (*alias)(m)}
+	if err := unmarshalStrict(b, &v); err != nil {
+		return err
+	}
+	m.Id, m.Tokenid = v.Id.String(), v.Tokenid.String()
+	return nil
+}
+
+// MoveLegacyIds moves the uint64 ids stored before uint256 to the decimal ones, reports whether moved.
+// the event keeps the store key of the legacy id until put again
+func MoveLegacyIds(e Event) bool {
+	switch v := e.(type) {
+	case *EventERC20Deposited:
+		if v.Id != "" {
+			return false
+		}
+		v.Id, v.LegacyId = strconv.FormatUint(v.LegacyId, 10), 0
+	case *EventNFTDeposited:
+		if v.Id != "" {
+			return false
+		}
+		v.Id, v.LegacyId = strconv.FormatUint(v.LegacyId, 10), 0
+		v.Tokenid, v.LegacyTokenid = strconv.FormatUint(v.LegacyTokenid, 10), 0
+	}
+	return true
+}
+
+// ParseUint256 parses the uint256 in decimal, such as the event id and the token id
+func ParseUint256(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Sign() < 0 || n.BitLen() > 256 {
+		return nil, ErrInvalidUint256
+	}
+	return n, nil
+}
+
+// eventStoreKey namespaces the id by the bank. the id is the 32 bytes big endian, ordered by the number.
+// the events stored before namespaced have no bank, which are moved to the bank by the migration
+func eventStoreKey(bank, id string) []byte {
+	var key []byte
+	if bank != "" {
+		key = common.HexToAddress(bank).Bytes()
+	}
+
+	// the invalid id never collides with the valid ones, rejected before stored though
+	n, err := ParseUint256(id)
+	if err != nil {
+		return append(key, id...)
+	}
+	return append(key, common.LeftPadBytes(n.Bytes(), 32)...)
+}
+
+func unmarshalStrict(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+func (x EventStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.String())
+}
+
+func (x *EventStatus) UnmarshalJSON(b []byte) error {
+	v, err := unmarshalEnumJSON(b, EventStatus_value)
+	if err != nil {
+		return err
+	}
+	*x = EventStatus(v)
+	return nil
+}
+
+// unmarshalEnumJSON accepts both the name and the number of the enum value
+func unmarshalEnumJSON(b []byte, values map[string]int32) (int32, error) {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		v, ok := values[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown enum value: %s", name)
+		}
+		return v, nil
+	}
+
+	var v int32
+	if err := json.Unmarshal(b, &v); err != nil {
+		return 0, err
 	}
-	return eventStoreNFT
+	return v, nil
 }
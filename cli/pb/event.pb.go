@@ -36,18 +36,21 @@ const (
 	EventStatus_UNDEFINED EventStatus = 0
 	EventStatus_FAILED    EventStatus = 1
 	EventStatus_SUCCEEDED EventStatus = 2
+	EventStatus_PAUSED    EventStatus = 3
 )
 
 var EventStatus_name = map[int32]string{
 	0: "UNDEFINED",
 	1: "FAILED",
 	2: "SUCCEEDED",
+	3: "PAUSED",
 }
 
 var EventStatus_value = map[string]int32{
 	"UNDEFINED": 0,
 	"FAILED":    1,
 	"SUCCEEDED": 2,
+	"PAUSED":    3,
 }
 
 func (x EventStatus) String() string {
@@ -59,16 +62,23 @@ func (EventStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type EventERC20Deposited struct {
-	Id                   uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token                string      `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Sender               string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
-	Amount               string      `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Retry                uint32      `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
-	Status               EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=tak1827.evmbridge.cli.EventStatus" json:"status,omitempty"`
-	UpdatedAt            *time.Time  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	// the id before uint256, moved to `id` by the migration
+	LegacyId  uint64      `protobuf:"varint,1,opt,name=legacy_id,json=legacyId,proto3" json:"legacy_id,omitempty"`
+	Token     string      `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Sender    string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount    string      `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Retry     uint32      `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
+	Status    EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=tak1827.evmbridge.cli.EventStatus" json:"status,omitempty"`
+	UpdatedAt *time.Time  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	// the time of the scheduled retry, unset unless waiting for the retry
+	NextAttemptAt *time.Time `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3,stdtime" json:"next_attempt_at,omitempty"`
+	// the bank contract which logged the deposit, the id is unique in the bank
+	Bank string `protobuf:"bytes,9,opt,name=bank,proto3" json:"bank,omitempty"`
+	// the id logged by the bank, the uint256 in decimal
+	Id                   string   `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EventERC20Deposited) Reset()      { *m = EventERC20Deposited{} }
@@ -103,9 +113,9 @@ func (m *EventERC20Deposited) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventERC20Deposited proto.InternalMessageInfo
 
-func (m *EventERC20Deposited) GetId() uint64 {
+func (m *EventERC20Deposited) GetLegacyId() uint64 {
 	if m != nil {
-		return m.Id
+		return m.LegacyId
 	}
 	return 0
 }
@@ -152,17 +162,48 @@ func (m *EventERC20Deposited) GetUpdatedAt() *time.Time {
 	return nil
 }
 
+func (m *EventERC20Deposited) GetNextAttemptAt() *time.Time {
+	if m != nil {
+		return m.NextAttemptAt
+	}
+	return nil
+}
+
+func (m *EventERC20Deposited) GetBank() string {
+	if m != nil {
+		return m.Bank
+	}
+	return ""
+}
+
+func (m *EventERC20Deposited) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type EventNFTDeposited struct {
-	Id                   uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token                string      `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	Sender               string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
-	Tokenid              uint64      `protobuf:"varint,4,opt,name=tokenid,proto3" json:"tokenid,omitempty"`
-	Retry                uint32      `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
-	Status               EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=tak1827.evmbridge.cli.EventStatus" json:"status,omitempty"`
-	UpdatedAt            *time.Time  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	// the id and the token id before uint256, moved to `id` and `tokenid` by the migration
+	LegacyId      uint64      `protobuf:"varint,1,opt,name=legacy_id,json=legacyId,proto3" json:"legacy_id,omitempty"`
+	Token         string      `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Sender        string      `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	LegacyTokenid uint64      `protobuf:"varint,4,opt,name=legacy_tokenid,json=legacyTokenid,proto3" json:"legacy_tokenid,omitempty"`
+	Retry         uint32      `protobuf:"varint,5,opt,name=retry,proto3" json:"retry,omitempty"`
+	Status        EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=tak1827.evmbridge.cli.EventStatus" json:"status,omitempty"`
+	UpdatedAt     *time.Time  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	// the time of the scheduled retry, unset unless waiting for the retry
+	NextAttemptAt *time.Time `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3,stdtime" json:"next_attempt_at,omitempty"`
+	// the bank contract which logged the deposit, the id is unique in the bank
+	Bank string `protobuf:"bytes,9,opt,name=bank,proto3" json:"bank,omitempty"`
+	// the token uri of the in chain nft, passed to the mint after rewritten by the pair
+	TokenUri string `protobuf:"bytes,10,opt,name=token_uri,json=tokenUri,proto3" json:"token_uri,omitempty"`
+	// the id logged by the bank and the token id, the uint256 in decimal
+	Id                   string   `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	Tokenid              string   `protobuf:"bytes,12,opt,name=tokenid,proto3" json:"tokenid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EventNFTDeposited) Reset()      { *m = EventNFTDeposited{} }
@@ -197,9 +238,9 @@ func (m *EventNFTDeposited) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EventNFTDeposited proto.InternalMessageInfo
 
-func (m *EventNFTDeposited) GetId() uint64 {
+func (m *EventNFTDeposited) GetLegacyId() uint64 {
 	if m != nil {
-		return m.Id
+		return m.LegacyId
 	}
 	return 0
 }
@@ -218,9 +259,9 @@ func (m *EventNFTDeposited) GetSender() string {
 	return ""
 }
 
-func (m *EventNFTDeposited) GetTokenid() uint64 {
+func (m *EventNFTDeposited) GetLegacyTokenid() uint64 {
 	if m != nil {
-		return m.Tokenid
+		return m.LegacyTokenid
 	}
 	return 0
 }
@@ -246,6 +287,41 @@ func (m *EventNFTDeposited) GetUpdatedAt() *time.Time {
 	return nil
 }
 
+func (m *EventNFTDeposited) GetNextAttemptAt() *time.Time {
+	if m != nil {
+		return m.NextAttemptAt
+	}
+	return nil
+}
+
+func (m *EventNFTDeposited) GetBank() string {
+	if m != nil {
+		return m.Bank
+	}
+	return ""
+}
+
+func (m *EventNFTDeposited) GetTokenUri() string {
+	if m != nil {
+		return m.TokenUri
+	}
+	return ""
+}
+
+func (m *EventNFTDeposited) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventNFTDeposited) GetTokenid() string {
+	if m != nil {
+		return m.Tokenid
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("tak1827.evmbridge.cli.EventStatus", EventStatus_name, EventStatus_value)
 	proto.RegisterType((*EventERC20Deposited)(nil), "tak1827.evmbridge.cli.EventERC20Deposited")
@@ -255,33 +331,39 @@ func init() {
 func init() { proto.RegisterFile("event.proto", fileDescriptor_2d17a9d3f0ddf27e) }
 
 var fileDescriptor_2d17a9d3f0ddf27e = []byte{
-	// 412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x90, 0xb1, 0x6e, 0xd4, 0x30,
-	0x1c, 0xc6, 0xcf, 0x47, 0x9a, 0xea, 0x7c, 0x6a, 0x75, 0x98, 0x82, 0xac, 0x1b, 0xdc, 0xa8, 0x62,
-	0x88, 0x90, 0x70, 0x20, 0x0c, 0x45, 0x2c, 0xa8, 0xbd, 0x38, 0x52, 0x25, 0x74, 0x43, 0xda, 0x2e,
-	0x2c, 0x28, 0x39, 0x9b, 0x60, 0xf5, 0x12, 0x47, 0x89, 0x73, 0x12, 0x1b, 0x8f, 0xc0, 0xc8, 0x23,
-	0xf0, 0x28, 0x8c, 0x8c, 0x6c, 0x70, 0xe1, 0x05, 0x78, 0x02, 0x84, 0x62, 0xe7, 0x24, 0x06, 0x46,
-	0x86, 0x6e, 0xfe, 0x3e, 0x7f, 0xff, 0x4f, 0xff, 0xdf, 0x1f, 0x4e, 0xc5, 0x46, 0x94, 0x9a, 0x56,
-	0xb5, 0xd2, 0x0a, 0xdd, 0xd7, 0xe9, 0xcd, 0xd3, 0xe7, 0xe1, 0x29, 0x15, 0x9b, 0x22, 0xab, 0x25,
-	0xcf, 0x05, 0x5d, 0xad, 0xe5, 0xfc, 0x28, 0x57, 0xb9, 0x32, 0x89, 0xa0, 0x7f, 0xd9, 0xf0, 0xfc,
-	0x38, 0x57, 0x2a, 0x5f, 0x8b, 0xc0, 0xa8, 0xac, 0x7d, 0x1b, 0x68, 0x59, 0x88, 0x46, 0xa7, 0x45,
-	0x65, 0x03, 0x27, 0xbf, 0x01, 0xbc, 0xc7, 0xfa, 0x76, 0x96, 0x2c, 0xc2, 0x27, 0x91, 0xa8, 0x54,
-	0x23, 0xb5, 0xe0, 0xe8, 0x10, 0x8e, 0x25, 0xc7, 0xc0, 0x03, 0xbe, 0x93, 0x8c, 0x25, 0x47, 0x47,
-	0x70, 0x4f, 0xab, 0x1b, 0x51, 0xe2, 0xb1, 0x07, 0xfc, 0x49, 0x62, 0x05, 0x7a, 0x00, 0xdd, 0x46,
-	0x94, 0x5c, 0xd4, 0xf8, 0x8e, 0xb1, 0x07, 0xd5, 0xfb, 0x69, 0xa1, 0xda, 0x52, 0x63, 0xc7, 0xfa,
-	0x56, 0xf5, 0x2d, 0xb5, 0xd0, 0xf5, 0x7b, 0xbc, 0xe7, 0x01, 0xff, 0x20, 0xb1, 0x02, 0xbd, 0x80,
-	0x6e, 0xa3, 0x53, 0xdd, 0x36, 0xd8, 0xf5, 0x80, 0x7f, 0x18, 0x9e, 0xd0, 0x7f, 0x22, 0x52, 0xb3,
-	0xe7, 0xa5, 0x49, 0x26, 0xc3, 0x04, 0x7a, 0x09, 0x61, 0x5b, 0xf1, 0x54, 0x0b, 0xfe, 0x26, 0xd5,
-	0x78, 0xdf, 0x03, 0xfe, 0x34, 0x9c, 0x53, 0x4b, 0x4d, 0x77, 0xd4, 0xf4, 0x6a, 0x47, 0x7d, 0xee,
-	0x7c, 0xfc, 0x7e, 0x0c, 0x92, 0xc9, 0x30, 0x73, 0xa6, 0xfb, 0x03, 0xdc, 0x35, 0xc5, 0xcb, 0xf8,
-	0xea, 0x7f, 0xe1, 0x63, 0xb8, 0x6f, 0x02, 0x92, 0x1b, 0x7e, 0x27, 0xd9, 0xc9, 0x5b, 0x78, 0x80,
-	0x47, 0xa7, 0x70, 0xfa, 0x57, 0x2f, 0x3a, 0x80, 0x93, 0xeb, 0x65, 0xc4, 0xe2, 0x8b, 0x25, 0x8b,
-	0x66, 0x23, 0x04, 0xa1, 0x1b, 0x9f, 0x5d, 0xbc, 0x62, 0xd1, 0x0c, 0xf4, 0x5f, 0x97, 0xd7, 0x8b,
-	0x05, 0x63, 0x11, 0x8b, 0x66, 0xe3, 0xf3, 0xf8, 0xdb, 0x96, 0x8c, 0x7e, 0x6d, 0x09, 0xf8, 0xd0,
-	0x11, 0xf0, 0xb9, 0x23, 0xe0, 0x4b, 0x47, 0xc0, 0xd7, 0x8e, 0x80, 0x1f, 0x1d, 0x01, 0x9f, 0x7e,
-	0x92, 0xd1, 0xeb, 0x87, 0xb9, 0xd4, 0xef, 0xda, 0x8c, 0xae, 0x54, 0x11, 0x0c, 0x44, 0x81, 0xd8,
-	0x14, 0x8f, 0x2d, 0x52, 0xb0, 0x5a, 0xcb, 0xa0, 0xca, 0x32, 0xd7, 0x6c, 0xf9, 0xec, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x67, 0x5c, 0xa9, 0x3f, 0xe6, 0x02, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x91, 0x4f, 0x8f, 0xd2, 0x40,
+	0x18, 0xc6, 0x19, 0x60, 0x59, 0xfa, 0x22, 0x88, 0xe3, 0x6a, 0x26, 0x6c, 0xd2, 0x25, 0x44, 0x13,
+	0x62, 0x62, 0xab, 0x78, 0xd0, 0x78, 0x31, 0x2c, 0x2d, 0x91, 0xc4, 0x10, 0x53, 0xe0, 0xe2, 0x85,
+	0xb4, 0xcc, 0x58, 0x27, 0xd0, 0x4e, 0x53, 0xa6, 0xc4, 0xbd, 0xf9, 0x11, 0x3c, 0xfa, 0x11, 0xfc,
+	0x12, 0xde, 0x3d, 0x7a, 0xf4, 0xa6, 0x8b, 0x5f, 0xc0, 0xab, 0x37, 0xd3, 0x99, 0x92, 0x78, 0xf0,
+	0xe0, 0xc5, 0xd3, 0x9e, 0x3a, 0xcf, 0xef, 0xfd, 0x93, 0xbe, 0xcf, 0x03, 0x0d, 0xb6, 0x63, 0xb1,
+	0xb4, 0x92, 0x54, 0x48, 0x81, 0x6f, 0x49, 0x7f, 0xfd, 0xf0, 0xc9, 0xe0, 0xb1, 0xc5, 0x76, 0x51,
+	0x90, 0x72, 0x1a, 0x32, 0x6b, 0xb5, 0xe1, 0x9d, 0x93, 0x50, 0x84, 0x42, 0x75, 0xd8, 0xf9, 0x4b,
+	0x37, 0x77, 0xce, 0x42, 0x21, 0xc2, 0x0d, 0xb3, 0x95, 0x0a, 0xb2, 0xd7, 0xb6, 0xe4, 0x11, 0xdb,
+	0x4a, 0x3f, 0x4a, 0x74, 0x43, 0xef, 0x57, 0x19, 0x6e, 0xba, 0xf9, 0x76, 0xd7, 0x1b, 0x0d, 0x1e,
+	0x38, 0x2c, 0x11, 0x5b, 0x2e, 0x19, 0xc5, 0xa7, 0x60, 0x6c, 0x58, 0xe8, 0xaf, 0x2e, 0x96, 0x9c,
+	0x12, 0xd4, 0x45, 0xfd, 0xaa, 0x57, 0xd7, 0x60, 0x42, 0xf1, 0x09, 0x1c, 0x49, 0xb1, 0x66, 0x31,
+	0x29, 0x77, 0x51, 0xdf, 0xf0, 0xb4, 0xc0, 0xb7, 0xa1, 0xb6, 0x65, 0x31, 0x65, 0x29, 0xa9, 0x28,
+	0x5c, 0xa8, 0x9c, 0xfb, 0x91, 0xc8, 0x62, 0x49, 0xaa, 0x9a, 0x6b, 0x95, 0x6f, 0x49, 0x99, 0x4c,
+	0x2f, 0xc8, 0x51, 0x17, 0xf5, 0x9b, 0x9e, 0x16, 0xf8, 0x29, 0xd4, 0xb6, 0xd2, 0x97, 0xd9, 0x96,
+	0xd4, 0xba, 0xa8, 0xdf, 0x1a, 0xf4, 0xac, 0xbf, 0xde, 0x6b, 0xa9, 0x9f, 0x9e, 0xa9, 0x4e, 0xaf,
+	0x98, 0xc0, 0xcf, 0x00, 0xb2, 0x84, 0xfa, 0x92, 0xd1, 0xa5, 0x2f, 0xc9, 0x71, 0x17, 0xf5, 0x1b,
+	0x83, 0x8e, 0xa5, 0x2d, 0xb0, 0x0e, 0x16, 0x58, 0xf3, 0x83, 0x05, 0xe7, 0xd5, 0xf7, 0xdf, 0xce,
+	0x90, 0x67, 0x14, 0x33, 0x43, 0x89, 0x9f, 0xc3, 0xf5, 0x98, 0xbd, 0x95, 0x4b, 0x5f, 0x4a, 0x16,
+	0x25, 0xf9, 0x97, 0xd4, 0xff, 0x71, 0x4b, 0x33, 0x1f, 0x1c, 0xea, 0xb9, 0xa1, 0xc4, 0x18, 0xaa,
+	0x81, 0x1f, 0xaf, 0x89, 0xa1, 0x4e, 0x56, 0x6f, 0xdc, 0x82, 0x32, 0xa7, 0x04, 0x14, 0x29, 0x73,
+	0xda, 0xfb, 0x54, 0x81, 0x1b, 0xea, 0x8c, 0xe9, 0x78, 0xfe, 0x5f, 0x9c, 0xbf, 0x0b, 0xad, 0x62,
+	0x95, 0xea, 0xe3, 0x54, 0x25, 0x50, 0xf5, 0x9a, 0x9a, 0xce, 0x35, 0xbc, 0x7a, 0x41, 0x9c, 0x82,
+	0xa1, 0x0c, 0x59, 0x66, 0x29, 0x2f, 0xf2, 0xa8, 0x2b, 0xb0, 0x48, 0x79, 0x91, 0x52, 0xe3, 0x90,
+	0x12, 0x26, 0x70, 0x7c, 0x70, 0xef, 0x9a, 0x82, 0x07, 0x79, 0x6f, 0x04, 0x8d, 0x3f, 0x8e, 0xc7,
+	0x4d, 0x30, 0x16, 0x53, 0xc7, 0x1d, 0x4f, 0xa6, 0xae, 0xd3, 0x2e, 0x61, 0x80, 0xda, 0x78, 0x38,
+	0x79, 0xe1, 0x3a, 0x6d, 0x94, 0x97, 0x66, 0x8b, 0xd1, 0xc8, 0x75, 0x1d, 0xd7, 0x69, 0x97, 0xf3,
+	0xd2, 0xcb, 0xe1, 0x62, 0xe6, 0x3a, 0xed, 0xca, 0xf9, 0xf8, 0xeb, 0xa5, 0x59, 0xfa, 0x79, 0x69,
+	0xa2, 0x77, 0x7b, 0x13, 0x7d, 0xdc, 0x9b, 0xe8, 0xf3, 0xde, 0x44, 0x5f, 0xf6, 0x26, 0xfa, 0xbe,
+	0x37, 0xd1, 0x87, 0x1f, 0x66, 0xe9, 0xd5, 0x9d, 0x90, 0xcb, 0x37, 0x59, 0x60, 0xad, 0x44, 0x64,
+	0x17, 0x11, 0xd8, 0x6c, 0x17, 0xdd, 0xd7, 0x19, 0xd8, 0xab, 0x0d, 0xb7, 0x93, 0x20, 0xa8, 0x29,
+	0x43, 0x1e, 0xfd, 0x1e, 0x00, 0xac, 0x8d, 0x89, 0x1a, 0x2c, 0x04, 0x00, 0x00,
 }
 
 func (this *EventERC20Deposited) Equal(that interface{}) bool {
@@ -303,7 +385,7 @@ func (this *EventERC20Deposited) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Id != that1.Id {
+	if this.LegacyId != that1.LegacyId {
 		return false
 	}
 	if this.Token != that1.Token {
@@ -328,6 +410,19 @@ func (this *EventERC20Deposited) Equal(that interface{}) bool {
 	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
 		return false
 	}
+	if that1.NextAttemptAt == nil {
+		if this.NextAttemptAt != nil {
+			return false
+		}
+	} else if !this.NextAttemptAt.Equal(*that1.NextAttemptAt) {
+		return false
+	}
+	if this.Bank != that1.Bank {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -352,7 +447,7 @@ func (this *EventNFTDeposited) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Id != that1.Id {
+	if this.LegacyId != that1.LegacyId {
 		return false
 	}
 	if this.Token != that1.Token {
@@ -361,7 +456,7 @@ func (this *EventNFTDeposited) Equal(that interface{}) bool {
 	if this.Sender != that1.Sender {
 		return false
 	}
-	if this.Tokenid != that1.Tokenid {
+	if this.LegacyTokenid != that1.LegacyTokenid {
 		return false
 	}
 	if this.Retry != that1.Retry {
@@ -377,6 +472,25 @@ func (this *EventNFTDeposited) Equal(that interface{}) bool {
 	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
 		return false
 	}
+	if that1.NextAttemptAt == nil {
+		if this.NextAttemptAt != nil {
+			return false
+		}
+	} else if !this.NextAttemptAt.Equal(*that1.NextAttemptAt) {
+		return false
+	}
+	if this.Bank != that1.Bank {
+		return false
+	}
+	if this.TokenUri != that1.TokenUri {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Tokenid != that1.Tokenid {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -386,15 +500,18 @@ func (this *EventERC20Deposited) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 14)
 	s = append(s, "&pb.EventERC20Deposited{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "LegacyId: "+fmt.Sprintf("%#v", this.LegacyId)+",\n")
 	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
 	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
 	s = append(s, "Amount: "+fmt.Sprintf("%#v", this.Amount)+",\n")
 	s = append(s, "Retry: "+fmt.Sprintf("%#v", this.Retry)+",\n")
 	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
 	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "NextAttemptAt: "+fmt.Sprintf("%#v", this.NextAttemptAt)+",\n")
+	s = append(s, "Bank: "+fmt.Sprintf("%#v", this.Bank)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -405,15 +522,20 @@ func (this *EventNFTDeposited) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 11)
+	s := make([]string, 0, 16)
 	s = append(s, "&pb.EventNFTDeposited{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "LegacyId: "+fmt.Sprintf("%#v", this.LegacyId)+",\n")
 	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
 	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
-	s = append(s, "Tokenid: "+fmt.Sprintf("%#v", this.Tokenid)+",\n")
+	s = append(s, "LegacyTokenid: "+fmt.Sprintf("%#v", this.LegacyTokenid)+",\n")
 	s = append(s, "Retry: "+fmt.Sprintf("%#v", this.Retry)+",\n")
 	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
 	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "NextAttemptAt: "+fmt.Sprintf("%#v", this.NextAttemptAt)+",\n")
+	s = append(s, "Bank: "+fmt.Sprintf("%#v", this.Bank)+",\n")
+	s = append(s, "TokenUri: "+fmt.Sprintf("%#v", this.TokenUri)+",\n")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Tokenid: "+fmt.Sprintf("%#v", this.Tokenid)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -452,14 +574,38 @@ func (m *EventERC20Deposited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.UpdatedAt != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Bank) > 0 {
+		i -= len(m.Bank)
+		copy(dAtA[i:], m.Bank)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Bank)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.NextAttemptAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextAttemptAt):])
 		if err1 != nil {
 			return 0, err1
 		}
 		i -= n1
 		i = encodeVarintEvent(dAtA, i, uint64(n1))
 		i--
+		dAtA[i] = 0x42
+	}
+	if m.UpdatedAt != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintEvent(dAtA, i, uint64(n2))
+		i--
 		dAtA[i] = 0x3a
 	}
 	if m.Status != 0 {
@@ -493,8 +639,8 @@ func (m *EventERC20Deposited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+	if m.LegacyId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.LegacyId))
 		i--
 		dAtA[i] = 0x8
 	}
@@ -525,13 +671,51 @@ func (m *EventNFTDeposited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Tokenid) > 0 {
+		i -= len(m.Tokenid)
+		copy(dAtA[i:], m.Tokenid)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Tokenid)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TokenUri) > 0 {
+		i -= len(m.TokenUri)
+		copy(dAtA[i:], m.TokenUri)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TokenUri)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Bank) > 0 {
+		i -= len(m.Bank)
+		copy(dAtA[i:], m.Bank)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Bank)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.NextAttemptAt != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextAttemptAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintEvent(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.UpdatedAt != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
-		if err2 != nil {
-			return 0, err2
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n2
-		i = encodeVarintEvent(dAtA, i, uint64(n2))
+		i -= n4
+		i = encodeVarintEvent(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -545,8 +729,8 @@ func (m *EventNFTDeposited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.Tokenid != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Tokenid))
+	if m.LegacyTokenid != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.LegacyTokenid))
 		i--
 		dAtA[i] = 0x20
 	}
@@ -564,8 +748,8 @@ func (m *EventNFTDeposited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Id != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+	if m.LegacyId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.LegacyId))
 		i--
 		dAtA[i] = 0x8
 	}
@@ -589,8 +773,8 @@ func (m *EventERC20Deposited) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvent(uint64(m.Id))
+	if m.LegacyId != 0 {
+		n += 1 + sovEvent(uint64(m.LegacyId))
 	}
 	l = len(m.Token)
 	if l > 0 {
@@ -614,6 +798,18 @@ func (m *EventERC20Deposited) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.NextAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextAttemptAt)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Bank)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -626,8 +822,8 @@ func (m *EventNFTDeposited) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvent(uint64(m.Id))
+	if m.LegacyId != 0 {
+		n += 1 + sovEvent(uint64(m.LegacyId))
 	}
 	l = len(m.Token)
 	if l > 0 {
@@ -637,8 +833,8 @@ func (m *EventNFTDeposited) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	if m.Tokenid != 0 {
-		n += 1 + sovEvent(uint64(m.Tokenid))
+	if m.LegacyTokenid != 0 {
+		n += 1 + sovEvent(uint64(m.LegacyTokenid))
 	}
 	if m.Retry != 0 {
 		n += 1 + sovEvent(uint64(m.Retry))
@@ -650,6 +846,26 @@ func (m *EventNFTDeposited) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.NextAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextAttemptAt)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Bank)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TokenUri)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Tokenid)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -667,13 +883,16 @@ func (this *EventERC20Deposited) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&EventERC20Deposited{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`LegacyId:` + fmt.Sprintf("%v", this.LegacyId) + `,`,
 		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
 		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
 		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
 		`Retry:` + fmt.Sprintf("%v", this.Retry) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "timestamppb.Timestamp", 1) + `,`,
+		`NextAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.NextAttemptAt), "Timestamp", "timestamppb.Timestamp", 1) + `,`,
+		`Bank:` + fmt.Sprintf("%v", this.Bank) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -684,13 +903,18 @@ func (this *EventNFTDeposited) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&EventNFTDeposited{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`LegacyId:` + fmt.Sprintf("%v", this.LegacyId) + `,`,
 		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
 		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
-		`Tokenid:` + fmt.Sprintf("%v", this.Tokenid) + `,`,
+		`LegacyTokenid:` + fmt.Sprintf("%v", this.LegacyTokenid) + `,`,
 		`Retry:` + fmt.Sprintf("%v", this.Retry) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "timestamppb.Timestamp", 1) + `,`,
+		`NextAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.NextAttemptAt), "Timestamp", "timestamppb.Timestamp", 1) + `,`,
+		`Bank:` + fmt.Sprintf("%v", this.Bank) + `,`,
+		`TokenUri:` + fmt.Sprintf("%v", this.TokenUri) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Tokenid:` + fmt.Sprintf("%v", this.Tokenid) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -735,9 +959,9 @@ func (m *EventERC20Deposited) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyId", wireType)
 			}
-			m.Id = 0
+			m.LegacyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -747,7 +971,7 @@ func (m *EventERC20Deposited) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.LegacyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -922,6 +1146,106 @@ func (m *EventERC20Deposited) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextAttemptAt == nil {
+				m.NextAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bank", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bank = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -975,9 +1299,9 @@ func (m *EventNFTDeposited) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyId", wireType)
 			}
-			m.Id = 0
+			m.LegacyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -987,7 +1311,7 @@ func (m *EventNFTDeposited) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.LegacyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1058,9 +1382,9 @@ func (m *EventNFTDeposited) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyTokenid", wireType)
 			}
-			m.Tokenid = 0
+			m.LegacyTokenid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1070,7 +1394,7 @@ func (m *EventNFTDeposited) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tokenid |= uint64(b&0x7F) << shift
+				m.LegacyTokenid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1149,6 +1473,170 @@ func (m *EventNFTDeposited) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextAttemptAt == nil {
+				m.NextAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bank", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bank = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokenid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1,46 +1,38 @@
 package pb
 
 import (
-	"github.com/tak1827/go-store/store"
+	"encoding/json"
+	"strings"
 )
 
 var (
 	PREFIX_ADDR_PAIR = []byte(".pair")
 
-	pairStore *store.PrefixStore
+	// changed on every pair write, so that the cached pairs can detect the change by other processes
+	KEY_PAIR_REVISION = []byte(".revisionpair") // not under PREFIX_ADDR_PAIR
 )
 
 func (m *Pair) StoreKey() []byte {
 	return []byte(m.GetInaddr())
 }
 
-func GetPair(db store.Store, inaddr string) (m Pair, err error) {
-	s := GetPairStore(db)
-
-	m.Inaddr = inaddr
-
-	value, err := s.Get(m.StoreKey())
-	if err != nil {
-		return
+// RewriteURI returns the token uri minted on the out chain, rewritten by the rule of the pair
+func (m *Pair) RewriteURI(uri string) string {
+	if (m.UriFrom == "" && m.UriTo == "") || !strings.HasPrefix(uri, m.UriFrom) {
+		return uri
 	}
-	err = m.Unmarshal(value)
-	return
+	return m.UriTo + strings.TrimPrefix(uri, m.UriFrom)
 }
 
-func (m *Pair) Put(db store.Store) error {
-	s := GetPairStore(db)
+func (x Pair_Type) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.String())
+}
 
-	value, err := m.Marshal()
+func (x *Pair_Type) UnmarshalJSON(b []byte) error {
+	v, err := unmarshalEnumJSON(b, Pair_Type_value)
 	if err != nil {
 		return err
 	}
-
-	return s.Put(m.StoreKey(), value)
-}
-
-func GetPairStore(db store.Store) *store.PrefixStore {
-	if pairStore == nil {
-		pairStore = store.NewPrefixStore(db, PREFIX_ADDR_PAIR)
-	}
-	return pairStore
+	*x = Pair_Type(v)
+	return nil
 }
@@ -56,14 +56,20 @@ func (Pair_Type) EnumDescriptor() ([]byte, []int) {
 }
 
 type Pair struct {
-	Inaddr               string     `protobuf:"bytes,1,opt,name=inaddr,proto3" json:"inaddr,omitempty"`
-	Outaddr              string     `protobuf:"bytes,2,opt,name=outaddr,proto3" json:"outaddr,omitempty"`
-	Intype               Pair_Type  `protobuf:"varint,3,opt,name=intype,proto3,enum=tak1827.evmbridge.cli.Pair_Type" json:"intype,omitempty"`
-	Outtype              Pair_Type  `protobuf:"varint,4,opt,name=outtype,proto3,enum=tak1827.evmbridge.cli.Pair_Type" json:"outtype,omitempty"`
-	UpdatedAt            *time.Time `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Inaddr    string     `protobuf:"bytes,1,opt,name=inaddr,proto3" json:"inaddr,omitempty"`
+	Outaddr   string     `protobuf:"bytes,2,opt,name=outaddr,proto3" json:"outaddr,omitempty"`
+	Intype    Pair_Type  `protobuf:"varint,3,opt,name=intype,proto3,enum=tak1827.evmbridge.cli.Pair_Type" json:"intype,omitempty"`
+	Outtype   Pair_Type  `protobuf:"varint,4,opt,name=outtype,proto3,enum=tak1827.evmbridge.cli.Pair_Type" json:"outtype,omitempty"`
+	UpdatedAt *time.Time `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	// the events of the disabled pair are kept paused, not minted
+	Disabled bool `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// the token uri of the nft starting with uri_from is minted starting with uri_to,
+	// e.g. "ipfs://" to the gateway url. kept as it is when both are empty
+	UriFrom              string   `protobuf:"bytes,7,opt,name=uri_from,json=uriFrom,proto3" json:"uri_from,omitempty"`
+	UriTo                string   `protobuf:"bytes,8,opt,name=uri_to,json=uriTo,proto3" json:"uri_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pair) Reset()      { *m = Pair{} }
@@ -133,6 +139,27 @@ func (m *Pair) GetUpdatedAt() *time.Time {
 	return nil
 }
 
+func (m *Pair) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *Pair) GetUriFrom() string {
+	if m != nil {
+		return m.UriFrom
+	}
+	return ""
+}
+
+func (m *Pair) GetUriTo() string {
+	if m != nil {
+		return m.UriTo
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("tak1827.evmbridge.cli.Pair_Type", Pair_Type_name, Pair_Type_value)
 	proto.RegisterType((*Pair)(nil), "tak1827.evmbridge.cli.Pair")
@@ -141,28 +168,31 @@ func init() {
 func init() { proto.RegisterFile("pair.proto", fileDescriptor_b6c646fab57af36d) }
 
 var fileDescriptor_b6c646fab57af36d = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2a, 0x48, 0xcc, 0x2c,
-	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x2d, 0x49, 0xcc, 0x36, 0xb4, 0x30, 0x32, 0xd7,
-	0x4b, 0x2d, 0xcb, 0x4d, 0x2a, 0xca, 0x4c, 0x49, 0x4f, 0xd5, 0x4b, 0xce, 0xc9, 0x94, 0x12, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0xe4, 0xd3, 0xf3, 0xf3, 0xd3,
-	0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x92, 0xcc, 0xdc, 0xd4, 0xe2, 0x92, 0xc4,
-	0xdc, 0x02, 0x88, 0x02, 0xa5, 0x49, 0x4c, 0x5c, 0x2c, 0x01, 0x89, 0x99, 0x45, 0x42, 0x62, 0x5c,
-	0x6c, 0x99, 0x79, 0x89, 0x29, 0x29, 0x45, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x50, 0x9e,
-	0x90, 0x04, 0x17, 0x7b, 0x7e, 0x69, 0x09, 0x58, 0x82, 0x09, 0x2c, 0x01, 0xe3, 0x0a, 0x59, 0x80,
-	0x74, 0x94, 0x54, 0x16, 0xa4, 0x4a, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x19, 0x29, 0xe8, 0x61, 0x75,
-	0x99, 0x1e, 0xc8, 0x78, 0xbd, 0x90, 0xca, 0x82, 0xd4, 0x20, 0xa8, 0x7a, 0x21, 0x2b, 0xb0, 0x99,
-	0x60, 0xad, 0x2c, 0x44, 0x6a, 0x85, 0x69, 0x10, 0xb2, 0xe7, 0xe2, 0x2a, 0x2d, 0x48, 0x49, 0x2c,
-	0x49, 0x4d, 0x89, 0x4f, 0x2c, 0x91, 0x60, 0x55, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd2, 0x83, 0x78,
-	0x53, 0x0f, 0xe6, 0x4d, 0xbd, 0x10, 0x98, 0x37, 0x9d, 0x58, 0x26, 0xdc, 0x97, 0x67, 0x0c, 0xe2,
-	0x84, 0xea, 0x71, 0x2c, 0x51, 0x52, 0xe4, 0x62, 0x01, 0x99, 0x28, 0xc4, 0xc3, 0xc5, 0xe1, 0x1f,
-	0xe4, 0xe9, 0xee, 0xe9, 0xe7, 0xe8, 0x23, 0xc0, 0x20, 0xc4, 0xcd, 0xc5, 0x1e, 0x1e, 0xe4, 0x18,
-	0x10, 0xe0, 0xea, 0x22, 0xc0, 0xe8, 0xe4, 0x76, 0xe3, 0xa1, 0x1c, 0xc3, 0x87, 0x87, 0x72, 0x8c,
-	0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x67, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x49, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x3a, 0x5d, 0x3f, 0xb5, 0x2c, 0x57, 0x17, 0xe2, 0x76,
-	0xfd, 0xe4, 0x9c, 0x4c, 0xfd, 0x82, 0xa4, 0x24, 0x36, 0xb0, 0x7b, 0x8c, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x24, 0x72, 0xa9, 0x1e, 0xbf, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x3b, 0x35, 0x4d, 0xd3, 0xa9, 0x48, 0x19, 0xac, 0xc4, 0x1c, 0xa6, 0xb1, 0x78, 0xc8,
+	0xc5, 0x09, 0xd6, 0x83, 0xc5, 0x8b, 0xb4, 0x68, 0xa5, 0x20, 0x5a, 0x42, 0x41, 0xf0, 0x52, 0x26,
+	0xcd, 0x34, 0x0e, 0x26, 0x4e, 0x98, 0x4e, 0x0a, 0xbd, 0xf9, 0x08, 0x1e, 0x7d, 0x04, 0x1f, 0xc5,
+	0xe3, 0x1e, 0xf7, 0xb6, 0x6d, 0xf6, 0x05, 0xf6, 0x11, 0x96, 0x4c, 0x92, 0x3d, 0xed, 0x61, 0x6f,
+	0xdf, 0x9f, 0xff, 0xff, 0xff, 0xf1, 0xfd, 0x3e, 0x08, 0x33, 0xca, 0x25, 0xc9, 0xa4, 0x50, 0x02,
+	0x0d, 0x15, 0xfd, 0xf9, 0x7a, 0x3a, 0x79, 0x4b, 0xd8, 0x21, 0x0d, 0x25, 0x8f, 0x62, 0x46, 0xb6,
+	0x09, 0x77, 0x9e, 0xc6, 0x22, 0x16, 0x3a, 0xe1, 0x97, 0x53, 0x15, 0x76, 0x46, 0xb1, 0x10, 0x71,
+	0xc2, 0x7c, 0xad, 0xc2, 0x7c, 0xe7, 0x2b, 0x9e, 0xb2, 0xbd, 0xa2, 0x69, 0x56, 0x05, 0xc6, 0xa7,
+	0x36, 0x34, 0x56, 0x94, 0x4b, 0xf4, 0x0c, 0x9a, 0xfc, 0x17, 0x8d, 0x22, 0x69, 0x03, 0x17, 0x78,
+	0xbd, 0xa0, 0x56, 0xc8, 0x86, 0x5d, 0x91, 0x2b, 0x6d, 0xb4, 0xb5, 0xd1, 0x48, 0x34, 0x2d, 0x1b,
+	0xea, 0x98, 0x31, 0xfb, 0x91, 0x0b, 0xbc, 0x27, 0x13, 0x97, 0xdc, 0x7b, 0x19, 0x29, 0xd7, 0x93,
+	0xf5, 0x31, 0x63, 0x41, 0x9d, 0x47, 0xef, 0xf4, 0x4e, 0x5d, 0x35, 0x1e, 0x58, 0x6d, 0x0a, 0xe8,
+	0x3d, 0x84, 0x79, 0x16, 0x51, 0xc5, 0xa2, 0x0d, 0x55, 0x76, 0xc7, 0x05, 0x5e, 0x7f, 0xe2, 0x90,
+	0x0a, 0x93, 0x34, 0x98, 0x64, 0xdd, 0x60, 0xce, 0x8d, 0x3f, 0x57, 0x23, 0x10, 0xf4, 0xea, 0xce,
+	0x4c, 0x21, 0x07, 0x5a, 0x11, 0xdf, 0xd3, 0x30, 0x61, 0x91, 0x6d, 0xba, 0xc0, 0xb3, 0x82, 0x3b,
+	0x8d, 0x9e, 0x43, 0x2b, 0x97, 0x7c, 0xb3, 0x93, 0x22, 0xb5, 0xbb, 0x15, 0x6d, 0x2e, 0xf9, 0x42,
+	0x8a, 0x14, 0x0d, 0xa1, 0x59, 0x5a, 0x4a, 0xd8, 0x96, 0x36, 0x3a, 0xb9, 0xe4, 0x6b, 0x31, 0x7e,
+	0x01, 0x8d, 0xf2, 0x3e, 0xf4, 0x18, 0x5a, 0x5f, 0x83, 0xe5, 0xa7, 0xe5, 0x97, 0xd9, 0xe7, 0x41,
+	0x0b, 0xf5, 0x61, 0xf7, 0x5b, 0x30, 0x5b, 0xad, 0x3e, 0x7e, 0x18, 0x80, 0xf9, 0xe2, 0xf2, 0x8c,
+	0x5b, 0x37, 0x67, 0x0c, 0x7e, 0x17, 0x18, 0xfc, 0x2b, 0x30, 0xf8, 0x5f, 0x60, 0x70, 0x51, 0x60,
+	0x70, 0x2a, 0x30, 0xf8, 0x7b, 0x8d, 0x5b, 0xdf, 0x5f, 0xc6, 0x5c, 0xfd, 0xc8, 0x43, 0xb2, 0x15,
+	0xa9, 0x5f, 0x3f, 0xc2, 0x67, 0x87, 0xf4, 0x55, 0xf5, 0x09, 0x7f, 0x9b, 0x70, 0x3f, 0x0b, 0x43,
+	0x53, 0xd3, 0xbd, 0xb9, 0x1d, 0x00, 0x8e, 0x5d, 0x61, 0x6a, 0x0d, 0x02, 0x00, 0x00,
 }
 
 func (this *Pair) Equal(that interface{}) bool {
@@ -203,6 +233,15 @@ func (this *Pair) Equal(that interface{}) bool {
 	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
 		return false
 	}
+	if this.Disabled != that1.Disabled {
+		return false
+	}
+	if this.UriFrom != that1.UriFrom {
+		return false
+	}
+	if this.UriTo != that1.UriTo {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
@@ -212,13 +251,16 @@ func (this *Pair) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 12)
 	s = append(s, "&pb.Pair{")
 	s = append(s, "Inaddr: "+fmt.Sprintf("%#v", this.Inaddr)+",\n")
 	s = append(s, "Outaddr: "+fmt.Sprintf("%#v", this.Outaddr)+",\n")
 	s = append(s, "Intype: "+fmt.Sprintf("%#v", this.Intype)+",\n")
 	s = append(s, "Outtype: "+fmt.Sprintf("%#v", this.Outtype)+",\n")
 	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "Disabled: "+fmt.Sprintf("%#v", this.Disabled)+",\n")
+	s = append(s, "UriFrom: "+fmt.Sprintf("%#v", this.UriFrom)+",\n")
+	s = append(s, "UriTo: "+fmt.Sprintf("%#v", this.UriTo)+",\n")
 	if this.XXX_unrecognized != nil {
 		s = append(s, "XXX_unrecognized:"+fmt.Sprintf("%#v", this.XXX_unrecognized)+",\n")
 	}
@@ -257,6 +299,30 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.UriTo) > 0 {
+		i -= len(m.UriTo)
+		copy(dAtA[i:], m.UriTo)
+		i = encodeVarintPair(dAtA, i, uint64(len(m.UriTo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UriFrom) > 0 {
+		i -= len(m.UriFrom)
+		copy(dAtA[i:], m.UriFrom)
+		i = encodeVarintPair(dAtA, i, uint64(len(m.UriFrom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.UpdatedAt != nil {
 		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
 		if err1 != nil {
@@ -329,6 +395,17 @@ func (m *Pair) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
 		n += 1 + l + sovPair(uint64(l))
 	}
+	if m.Disabled {
+		n += 2
+	}
+	l = len(m.UriFrom)
+	if l > 0 {
+		n += 1 + l + sovPair(uint64(l))
+	}
+	l = len(m.UriTo)
+	if l > 0 {
+		n += 1 + l + sovPair(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -351,6 +428,9 @@ func (this *Pair) String() string {
 		`Intype:` + fmt.Sprintf("%v", this.Intype) + `,`,
 		`Outtype:` + fmt.Sprintf("%v", this.Outtype) + `,`,
 		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "timestamppb.Timestamp", 1) + `,`,
+		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
+		`UriFrom:` + fmt.Sprintf("%v", this.UriFrom) + `,`,
+		`UriTo:` + fmt.Sprintf("%v", this.UriTo) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -531,6 +611,90 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPair(dAtA[iNdEx:])
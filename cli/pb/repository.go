@@ -0,0 +1,255 @@
+package pb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lithdew/bytesutil"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/go-store/store"
+)
+
+// Repository persists the models under their prefixes of the db.
+// each bridge owns its repository, so that multiple dbs can be used in a process
+type Repository struct {
+	DB store.Store
+
+	blocks      *store.PrefixStore
+	pairs       *store.PrefixStore
+	eventsERC20 *store.PrefixStore
+	eventsNFT   *store.PrefixStore
+	outbox      *store.PrefixStore
+	auditLog    *store.PrefixStore
+}
+
+func NewRepository(s store.Store) *Repository {
+	return &Repository{
+		DB:          s,
+		blocks:      store.NewPrefixStore(s, PREFIX_CONFIRMED_BLOCK),
+		pairs:       store.NewPrefixStore(s, PREFIX_ADDR_PAIR),
+		eventsERC20: store.NewPrefixStore(s, PREFIX_EVENT_ERC20),
+		eventsNFT:   store.NewPrefixStore(s, PREFIX_EVENT_NFT),
+		outbox:      store.NewPrefixStore(s, PREFIX_WEBHOOK_OUTBOX),
+		auditLog:    store.NewPrefixStore(s, PREFIX_AUDIT_LOG),
+	}
+}
+
+func (r *Repository) Close() error {
+	return r.DB.Close()
+}
+
+// GetConfirmedBlock returns the zero block when not stored yet
+func (r *Repository) GetConfirmedBlock(bank string, t BlockType) (m ConfirmedBlock, err error) {
+	v, err := r.blocks.Get(confirmedBlockKey(bank, t))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			err = nil
+		}
+		return
+	}
+	err = m.Unmarshal(v)
+	return
+}
+
+func (r *Repository) PutConfirmedBlock(bank string, t BlockType, m ConfirmedBlock) error {
+	value, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	return r.blocks.Put(confirmedBlockKey(bank, t), value)
+}
+
+// IterateConfirmedBlocks iterates the stored blocks of all banks
+func (r *Repository) IterateConfirmedBlocks(fn func(bank string, t BlockType, m *ConfirmedBlock) error) error {
+	return db.Iterate(r.DB, PREFIX_CONFIRMED_BLOCK, func(key, value []byte) error {
+		bank, t, err := parseConfirmedBlockKey(key)
+		if err != nil {
+			return err
+		}
+		var m ConfirmedBlock
+		if err = m.Unmarshal(value); err != nil {
+			return err
+		}
+		return fn(bank, t, &m)
+	})
+}
+
+func (r *Repository) GetPair(inaddr string) (m Pair, err error) {
+	m.Inaddr = inaddr
+
+	value, err := r.pairs.Get(m.StoreKey())
+	if err != nil {
+		return
+	}
+	err = m.Unmarshal(value)
+	return
+}
+
+func (r *Repository) PutPair(m *Pair) error {
+	value, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	if err = r.pairs.Put(m.StoreKey(), value); err != nil {
+		return err
+	}
+	return r.touchPairs()
+}
+
+func (r *Repository) DeletePair(inaddr string) error {
+	m := Pair{Inaddr: inaddr}
+	if err := r.pairs.Delete(m.StoreKey()); err != nil {
+		return err
+	}
+	return r.touchPairs()
+}
+
+// PairRevision returns the revision of the pairs, 0 when never written
+func (r *Repository) PairRevision() (uint64, error) {
+	v, err := r.DB.Get(KEY_PAIR_REVISION)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return bytesutil.Uint64BE(v), nil
+}
+
+// touchPairs stamps the new revision. the time is used instead of the counter,
+// since the counter incremented by the processes at the same time may lose the change
+func (r *Repository) touchPairs() error {
+	return r.DB.Put(KEY_PAIR_REVISION, bytesutil.AppendUint64BE(nil, uint64(time.Now().UnixNano())))
+}
+
+func (r *Repository) ListPairs() (pairs []Pair, err error) {
+	err = db.Iterate(r.DB, PREFIX_ADDR_PAIR, func(key, value []byte) error {
+		var m Pair
+		if err := m.Unmarshal(value); err != nil {
+			return err
+		}
+		pairs = append(pairs, m)
+		return nil
+	})
+	return
+}
+
+// GetEvent fills the event by its id
+func (r *Repository) GetEvent(e Event) error {
+	v, err := r.eventStore(e).Get(e.StoreKey())
+	if err != nil {
+		return err
+	}
+	return e.Unmarshal(v)
+}
+
+func (r *Repository) PutEvent(e Event) error {
+	value, err := e.Marshal()
+	if err != nil {
+		return err
+	}
+	return r.eventStore(e).Put(e.StoreKey(), value)
+}
+
+func (r *Repository) IterateEventsERC20(fn func(e *EventERC20Deposited) error) error {
+	return db.Iterate(r.DB, PREFIX_EVENT_ERC20, func(key, value []byte) error {
+		var m EventERC20Deposited
+		if err := m.Unmarshal(value); err != nil {
+			return err
+		}
+		return fn(&m)
+	})
+}
+
+func (r *Repository) IterateEventsNFT(fn func(e *EventNFTDeposited) error) error {
+	return db.Iterate(r.DB, PREFIX_EVENT_NFT, func(key, value []byte) error {
+		var m EventNFTDeposited
+		if err := m.Unmarshal(value); err != nil {
+			return err
+		}
+		return fn(&m)
+	})
+}
+
+func (r *Repository) PutDelivery(m *WebhookDelivery) error {
+	value, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	return r.outbox.Put(m.StoreKey(), value)
+}
+
+func (r *Repository) DeleteDelivery(m *WebhookDelivery) error {
+	return r.outbox.Delete(m.StoreKey())
+}
+
+// ListDeliveries returns the deliveries in the outbox
+func (r *Repository) ListDeliveries() (ms []WebhookDelivery, err error) {
+	err = db.Iterate(r.DB, PREFIX_WEBHOOK_OUTBOX, func(key, value []byte) error {
+		var m WebhookDelivery
+		if err := m.Unmarshal(value); err != nil {
+			return err
+		}
+		ms = append(ms, m)
+		return nil
+	})
+	return
+}
+
+// GetAuditEntry returns `store.ErrNotFound` when the seq is not written
+func (r *Repository) GetAuditEntry(seq uint64) (m AuditEntry, err error) {
+	m.Seq = seq
+
+	value, err := r.auditLog.Get(m.StoreKey())
+	if err != nil {
+		return
+	}
+	err = m.Unmarshal(value)
+	return
+}
+
+// PutAuditEntry writes the entry, then moves the head to it
+func (r *Repository) PutAuditEntry(m *AuditEntry) error {
+	value, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	if err = r.auditLog.Put(m.StoreKey(), value); err != nil {
+		return err
+	}
+	return r.DB.Put(KEY_AUDIT_HEAD, bytesutil.AppendUint64BE(nil, m.Seq))
+}
+
+// AuditHead returns the seq of the last entry, false when no entry is written
+func (r *Repository) AuditHead() (uint64, bool, error) {
+	v, err := r.DB.Get(KEY_AUDIT_HEAD)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return bytesutil.Uint64BE(v), true, nil
+}
+
+func (r *Repository) IterateAuditLog(fn func(m *AuditEntry) error) error {
+	return db.Iterate(r.DB, PREFIX_AUDIT_LOG, func(key, value []byte) error {
+		var m AuditEntry
+		if err := m.Unmarshal(value); err != nil {
+			return err
+		}
+		return fn(&m)
+	})
+}
+
+func (r *Repository) eventStore(e Event) *store.PrefixStore {
+	switch v := e.(type) {
+	case *EventERC20Deposited:
+		return r.eventsERC20
+	case *EventNFTDeposited:
+		return r.eventsNFT
+	default:
+		panic(fmt.Sprintf("unexpected type(%T)\n", v))
+	}
+}
@@ -0,0 +1,108 @@
+package pb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/go-store/store"
+)
+
+func TestRepositoryIsolation(t *testing.T) {
+	var (
+		r1 = NewRepository(db.NewMemDB())
+		r2 = NewRepository(db.NewMemDB())
+	)
+
+	require.NoError(t, r1.PutPair(&Pair{Inaddr: "0x01", Outaddr: "0x02"}))
+	require.NoError(t, r1.PutConfirmedBlock("", BlockERC20, ConfirmedBlock{Number: 10}))
+	require.NoError(t, r1.PutEvent(&EventERC20Deposited{Id: "1", Amount: "100", Status: EventStatus_SUCCEEDED}))
+
+	// the second repository never sees the records of the first one
+	_, err := r2.GetPair("0x01")
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	block, err := r2.GetConfirmedBlock("", BlockERC20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), block.Number)
+
+	require.ErrorIs(t, r2.GetEvent(&EventERC20Deposited{Id: "1"}), store.ErrNotFound)
+
+	// the first repository keeps its own records
+	block, err = r1.GetConfirmedBlock("", BlockERC20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), block.Number)
+
+	e := &EventERC20Deposited{Id: "1"}
+	require.NoError(t, r1.GetEvent(e))
+	require.Equal(t, "100", e.Amount)
+	require.Equal(t, EventStatus_SUCCEEDED, e.Status)
+
+	// the events of the other types are kept under the other prefix
+	require.ErrorIs(t, r1.GetEvent(&EventNFTDeposited{Id: "1"}), store.ErrNotFound)
+
+	pairs, err := r1.ListPairs()
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+	require.Equal(t, "0x02", pairs[0].Outaddr)
+}
+
+func TestBankNamespace(t *testing.T) {
+	var (
+		r     = NewRepository(db.NewMemDB())
+		bank1 = "0x1111111111111111111111111111111111111111"
+		bank2 = "0x2222222222222222222222222222222222222222"
+	)
+
+	require.NoError(t, r.PutEvent(&EventERC20Deposited{Id: "0", Bank: bank1, Amount: "1"}))
+	require.NoError(t, r.PutEvent(&EventERC20Deposited{Id: "0", Bank: bank2, Amount: "2"}))
+	require.NoError(t, r.PutConfirmedBlock(bank1, BlockERC20, ConfirmedBlock{Number: 10}))
+	require.NoError(t, r.PutConfirmedBlock(bank2, BlockNFT, ConfirmedBlock{Number: 20}))
+
+	// the same id is unique in the bank
+	e := &EventERC20Deposited{Id: "0", Bank: bank2}
+	require.NoError(t, r.GetEvent(e))
+	require.Equal(t, "2", e.Amount)
+	require.ErrorIs(t, r.GetEvent(&EventERC20Deposited{Id: "0"}), store.ErrNotFound)
+
+	block, err := r.GetConfirmedBlock(bank1, BlockNFT)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), block.Number)
+
+	blocks := make(map[string]uint64)
+	require.NoError(t, r.IterateConfirmedBlocks(func(bank string, t BlockType, m *ConfirmedBlock) error {
+		blocks[bank+string(t.StoreKey())] = m.Number
+		return nil
+	}))
+	require.Equal(t, map[string]uint64{bank1 + ".erc20": 10, bank2 + ".nft": 20}, blocks)
+}
+
+func TestUint256Ids(t *testing.T) {
+	const (
+		bank = "0x1111111111111111111111111111111111111111"
+		max  = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	)
+	r := NewRepository(db.NewMemDB())
+
+	for _, id := range []string{max, "256", "1"} {
+		require.NoError(t, r.PutEvent(&EventNFTDeposited{Id: id, Bank: bank, Tokenid: max}))
+	}
+
+	// ordered by the number
+	var ids []string
+	require.NoError(t, r.IterateEventsNFT(func(e *EventNFTDeposited) error {
+		ids = append(ids, e.Id)
+		return nil
+	}))
+	require.Equal(t, []string{"1", "256", max}, ids)
+
+	e, err := NewEvent(EventTypeNFT, bank, "00"+max)
+	require.NoError(t, err)
+	require.NoError(t, r.GetEvent(e))
+	require.Equal(t, max, e.(*EventNFTDeposited).Tokenid)
+
+	for _, id := range []string{"", "-1", "0x01", max + "0"} {
+		_, err = NewEvent(EventTypeNFT, bank, id)
+		require.ErrorIs(t, err, ErrInvalidUint256)
+	}
+}
@@ -0,0 +1,28 @@
+package pb
+
+import (
+	"fmt"
+)
+
+func ToAnyEvent(e Event) AnyEvent {
+	switch v := e.(type) {
+	case *EventERC20Deposited:
+		return AnyEvent{Event: &AnyEvent_Erc20{Erc20: v}}
+	case *EventNFTDeposited:
+		return AnyEvent{Event: &AnyEvent_Nft{Nft: v}}
+	default:
+		panic(fmt.Sprintf("unexpected type(%T)\n", v))
+	}
+}
+
+// Unwrap returns the contained event, nil when empty
+func (m *AnyEvent) Unwrap() Event {
+	switch v := m.GetEvent().(type) {
+	case *AnyEvent_Erc20:
+		return v.Erc20
+	case *AnyEvent_Nft:
+		return v.Nft
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,14 @@
+package pb
+
+import (
+	"github.com/lithdew/bytesutil"
+)
+
+var (
+	PREFIX_WEBHOOK_OUTBOX = []byte(".outboxwebhook")
+)
+
+// StoreKey orders the deliveries by id
+func (m *WebhookDelivery) StoreKey() []byte {
+	return bytesutil.AppendUint64BE(nil, m.GetId())
+}
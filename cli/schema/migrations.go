@@ -0,0 +1,262 @@
+package schema
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/go-store/store"
+)
+
+var (
+	ErrUnknownBank   = errors.New("the bank of the stored records is unknown")
+	ErrMalformedWire = errors.New("malformed protobuf wire")
+)
+
+// Migrations is the registry run at the bridge start and by `bridgecli db migrate`.
+// append new migrations at the end, never change the released ones.
+// each migration freezes the key layouts and the field numbers it depends on, never refer to the current `pb`
+var Migrations = Registry{
+	{
+		Version: 1,
+		Name:    "introduce schema version",
+		// the records of the unversioned homes are kept as they are
+		Migrate: func(s store.Store, env Env) error { return nil },
+	},
+	{
+		Version: 2,
+		Name:    "namespace the events and the cursors by the bank",
+		Migrate: namespaceByBank,
+	},
+	{
+		Version: 3,
+		Name:    "carry the event ids and the token ids as uint256",
+		Migrate: uint256Ids,
+	},
+}
+
+// the layouts shared by the versions up to 3
+var (
+	prefixEventERC20     = []byte(".eventerc20")
+	prefixEventNFT       = []byte(".eventnft")
+	prefixConfirmedBlock = []byte(".confirmedblok")
+	keyBlockERC20        = []byte(".erc20")
+	keyBlockNFT          = []byte(".nft")
+)
+
+// the event keys without the prefix by the version
+const (
+	v1EventKeyLen = 8                             // the uint64 id
+	v2EventKeyLen = common.AddressLength + 8      // the bank and the uint64 id
+	v3EventKeyLen = common.AddressLength + 32     // the bank and the uint256 id
+	v3IDPadding   = v3EventKeyLen - v2EventKeyLen // the zeros left padding the uint64 id
+)
+
+// the field numbers of the events by the version
+const (
+	v2FieldBank = 9 // both erc20 and nft
+
+	v3FieldLegacyID      = 1 // both erc20 and nft
+	v3FieldLegacyTokenid = 4 // nft
+	v3FieldERC20ID       = 10
+	v3FieldNFTID         = 11
+	v3FieldNFTTokenid    = 12
+)
+
+// the wire types of the protobuf
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// namespaceByBank moves the events and the cursors without the bank under the bank of the env,
+// they were stored when the bridge watched the single bank.
+// the events keyed by the uint64 id alone get the bank prefixed to the key, and the bank field appended to the value
+func namespaceByBank(s store.Store, env Env) error {
+	events := make(map[string][]record)
+	for _, prefix := range [][]byte{prefixEventERC20, prefixEventNFT} {
+		rs, err := records(s, prefix, func(key []byte) bool { return len(key) == v1EventKeyLen })
+		if err != nil {
+			return err
+		}
+		events[string(prefix)] = rs
+	}
+
+	blocks, err := records(s, prefixConfirmedBlock, func(key []byte) bool {
+		return string(key) == string(keyBlockERC20) || string(key) == string(keyBlockNFT)
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(events[string(prefixEventERC20)]) == 0 && len(events[string(prefixEventNFT)]) == 0 && len(blocks) == 0 {
+		return nil
+	}
+	if !common.IsHexAddress(env.Bank) {
+		return fmt.Errorf("%w, set the bank watched so far first, bank: %q", ErrUnknownBank, env.Bank)
+	}
+	bank := common.HexToAddress(env.Bank)
+
+	// collected before written, so that the iteration never sees the moved ones
+	for prefix, rs := range events {
+		for _, r := range rs {
+			value := appendStringField(r.value, v2FieldBank, bank.Hex())
+			if err := move(s, []byte(prefix), r.key, joinKey(bank.Bytes(), r.key), value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, r := range blocks {
+		if err := move(s, prefixConfirmedBlock, r.key, joinKey(bank.Bytes(), r.key), r.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// uint256Ids moves the uint64 ids to the uint256 ones in decimal, and re-keys the events by the 32 bytes id
+func uint256Ids(s store.Store, env Env) error {
+	for _, prefix := range [][]byte{prefixEventERC20, prefixEventNFT} {
+		rs, err := records(s, prefix, func(key []byte) bool { return len(key) == v2EventKeyLen })
+		if err != nil {
+			return err
+		}
+
+		for _, r := range rs {
+			var (
+				bank, id = r.key[:common.AddressLength], r.key[common.AddressLength:]
+				nft      = string(prefix) == string(prefixEventNFT)
+			)
+
+			fields, err := splitWire(r.value)
+			if err != nil {
+				return fmt.Errorf("event(%x): %w", r.key, err)
+			}
+			var (
+				value   []byte
+				tokenid uint64
+			)
+			for _, f := range fields {
+				switch {
+				case f.num == v3FieldLegacyID && f.typ == wireVarint:
+				case nft && f.num == v3FieldLegacyTokenid && f.typ == wireVarint:
+					tokenid = f.varint
+				default:
+					value = append(value, f.raw...)
+				}
+			}
+
+			// the id of the key is used, the zero one is omitted from the value
+			decimal := strconv.FormatUint(binary.BigEndian.Uint64(id), 10)
+			if nft {
+				value = appendStringField(value, v3FieldNFTID, decimal)
+				value = appendStringField(value, v3FieldNFTTokenid, strconv.FormatUint(tokenid, 10))
+			} else {
+				value = appendStringField(value, v3FieldERC20ID, decimal)
+			}
+
+			if err := move(s, prefix, r.key, joinKey(bank, make([]byte, v3IDPadding), id), value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// record is the raw key, without the prefix, and the value
+type record struct {
+	key   []byte
+	value []byte
+}
+
+// records reads the records of the prefix whose keys match
+func records(s store.Store, prefix []byte, match func(key []byte) bool) (rs []record, err error) {
+	err = db.Iterate(s, prefix, func(key, value []byte) error {
+		if match(key) {
+			rs = append(rs, record{key: joinKey(key), value: joinKey(value)})
+		}
+		return nil
+	})
+	return
+}
+
+// move puts the value under the new key before deleting the old one,
+// so that the record is never lost, the migration is re-run from the remains
+func move(s store.Store, prefix, from, to, value []byte) error {
+	if err := s.Put(joinKey(prefix, to), value); err != nil {
+		return err
+	}
+	return s.Delete(joinKey(prefix, from))
+}
+
+// joinKey concatenates the parts into the new slice, never sharing the backing array of the parts
+func joinKey(parts ...[]byte) []byte {
+	var key []byte
+	for _, p := range parts {
+		key = append(key, p...)
+	}
+	return key
+}
+
+// wireField is the field of the encoded protobuf message, raw includes the tag
+type wireField struct {
+	num    uint64
+	typ    uint64
+	varint uint64
+	raw    []byte
+}
+
+// splitWire splits the encoded message into the fields, the groups are not supported
+func splitWire(b []byte) (fields []wireField, err error) {
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return nil, ErrMalformedWire
+		}
+		f := wireField{num: tag >> 3, typ: tag & 7}
+
+		size := n
+		switch f.typ {
+		case wireVarint:
+			v, m := binary.Uvarint(b[size:])
+			if m <= 0 {
+				return nil, ErrMalformedWire
+			}
+			f.varint, size = v, size+m
+		case wireFixed64:
+			size += 8
+		case wireBytes:
+			l, m := binary.Uvarint(b[size:])
+			if m <= 0 || l > uint64(len(b)) {
+				return nil, ErrMalformedWire
+			}
+			size += m + int(l)
+		case wireFixed32:
+			size += 4
+		default:
+			return nil, fmt.Errorf("%w, wire type: %d", ErrMalformedWire, f.typ)
+		}
+		if size > len(b) {
+			return nil, ErrMalformedWire
+		}
+
+		f.raw, b = b[:size], b[size:]
+		fields = append(fields, f)
+	}
+	return
+}
+
+// appendStringField appends the field to the encoded message, which overrides the same field before it
+func appendStringField(b []byte, num uint64, s string) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	b = append(b, buf[:binary.PutUvarint(buf[:], num<<3|wireBytes)]...)
+	b = append(b, buf[:binary.PutUvarint(buf[:], uint64(len(s)))]...)
+	return append(b, s...)
+}
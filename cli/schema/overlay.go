@@ -0,0 +1,141 @@
+package schema
+
+import (
+	"sort"
+
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/go-store/store"
+)
+
+var (
+	_ store.Store = (*overlay)(nil)
+	_ db.Iterator = (*overlay)(nil)
+)
+
+type change struct {
+	value   []byte
+	deleted bool
+}
+
+// overlay buffers the writes of a migration on top of the base store.
+// the buffered changes are applied by `commit`, or simply discarded on dry runs
+type overlay struct {
+	base    store.Store
+	changes map[string]change
+}
+
+func newOverlay(base store.Store) *overlay {
+	return &overlay{
+		base:    base,
+		changes: make(map[string]change),
+	}
+}
+
+func (o *overlay) Close() error {
+	return nil
+}
+
+func (o *overlay) Get(key []byte) ([]byte, error) {
+	if c, ok := o.changes[string(key)]; ok {
+		if c.deleted {
+			return nil, store.ErrNotFound
+		}
+		return c.value, nil
+	}
+	return o.base.Get(key)
+}
+
+func (o *overlay) Put(key, value []byte) error {
+	o.changes[string(key)] = change{value: append([]byte(nil), value...)}
+	return nil
+}
+
+func (o *overlay) Delete(key []byte) error {
+	o.changes[string(key)] = change{deleted: true}
+	return nil
+}
+
+func (o *overlay) Has(key []byte) (bool, error) {
+	if c, ok := o.changes[string(key)]; ok {
+		return !c.deleted, nil
+	}
+	return o.base.Has(key)
+}
+
+func (o *overlay) Dir() string {
+	return o.base.Dir()
+}
+
+func (o *overlay) Iterate(prefix []byte, fn func(key, value []byte) error) error {
+	merged := make(map[string][]byte)
+	if err := db.Iterate(o.base, prefix, func(key, value []byte) error {
+		merged[string(key)] = append([]byte(nil), value...)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for k, c := range o.changes {
+		if len(k) < len(prefix) || k[:len(prefix)] != string(prefix) {
+			continue
+		}
+		if c.deleted {
+			delete(merged, k[len(prefix):])
+		} else {
+			merged[k[len(prefix):]] = c.value
+		}
+	}
+
+	keys := make([]string, 0, len(merged))
+	for k := range merged {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := fn([]byte(k), merged[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// counts returns the number of the buffered writes and deletes
+func (o *overlay) counts() (writes, deletes int) {
+	for _, c := range o.changes {
+		if c.deleted {
+			deletes++
+		} else {
+			writes++
+		}
+	}
+	return
+}
+
+// commit applies the writes before the deletes, so that the moved record is never lost when failed in the middle,
+// the migration is re-run from the remains as the version is not bumped
+func (o *overlay) commit() error {
+	keys := make([]string, 0, len(o.changes))
+	for k := range o.changes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if c := o.changes[k]; !c.deleted {
+			if err := o.base.Put([]byte(k), c.value); err != nil {
+				return err
+			}
+		}
+	}
+	for _, k := range keys {
+		if c := o.changes[k]; c.deleted {
+			if err := o.base.Delete([]byte(k)); err != nil {
+				return err
+			}
+		}
+	}
+
+	o.changes = make(map[string]change)
+	return nil
+}
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lithdew/bytesutil"
+	"github.com/tak1827/go-store/store"
+)
+
+var (
+	KEY_SCHEMA_VERSION = []byte(".schemaversion")
+
+	ErrSchemaTooNew   = errors.New("the db schema is newer than this binary supports")
+	ErrInvalidVersion = errors.New("invalid schema version")
+)
+
+// Migration converts the records of the previous version into `Version`
+type Migration struct {
+	Version uint32
+	Name    string
+	Migrate func(s store.Store, env Env) error
+}
+
+// Env is the deployment, which the migrations may depend on
+type Env struct {
+	// the bank which logged the records stored before namespaced by the bank
+	Bank string
+}
+
+// Result reports the changes made, or would be made on dry runs, by a migration
+type Result struct {
+	Migration Migration
+	Writes    int
+	Deletes   int
+}
+
+// Registry is the list of migrations ordered by the version
+type Registry []Migration
+
+// Version returns the schema version of the db, 0 for the homes created before versioning
+func Version(s store.Store) (uint32, error) {
+	v, err := s.Get(KEY_SCHEMA_VERSION)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if len(v) != 4 {
+		return 0, fmt.Errorf("%w, len: %d", ErrInvalidVersion, len(v))
+	}
+	return bytesutil.Uint32BE(v), nil
+}
+
+// SetVersion overwrites the schema version, used when the records are loaded in the version
+func SetVersion(s store.Store, version uint32) error {
+	return s.Put(KEY_SCHEMA_VERSION, bytesutil.AppendUint32BE(nil, version))
+}
+
+// Latest returns the version the registry migrates up to
+func (r Registry) Latest() uint32 {
+	if len(r) == 0 {
+		return 0
+	}
+	return r[len(r)-1].Version
+}
+
+// Pending returns the migrations not applied to the db yet
+func (r Registry) Pending(s store.Store) ([]Migration, error) {
+	current, err := Version(s)
+	if err != nil {
+		return nil, err
+	}
+	if current > r.Latest() {
+		return nil, fmt.Errorf("%w, db: %d, supported: %d", ErrSchemaTooNew, current, r.Latest())
+	}
+
+	for i := range r {
+		if r[i].Version > current {
+			return r[i:], nil
+		}
+	}
+	return nil, nil
+}
+
+// Migrate applies the pending migrations in order, each one is committed with its version.
+// nothing is written to the db on dry runs
+func (r Registry) Migrate(s store.Store, env Env, dryRun bool) (results []Result, err error) {
+	pending, err := r.Pending(s)
+	if err != nil {
+		return
+	}
+
+	base := s
+	for _, m := range pending {
+		o := newOverlay(base)
+		if err = m.Migrate(o, env); err != nil {
+			err = fmt.Errorf("failed to migrate to version %d(%s): %w", m.Version, m.Name, err)
+			return
+		}
+
+		res := Result{Migration: m}
+		res.Writes, res.Deletes = o.counts()
+		results = append(results, res)
+
+		if dryRun {
+			// stack the next migration on the buffered changes, so that it sees them
+			base = o
+			continue
+		}
+
+		if err = o.commit(); err != nil {
+			return
+		}
+		if err = SetVersion(s, m.Version); err != nil {
+			return
+		}
+	}
+
+	return
+}
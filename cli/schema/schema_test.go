@@ -0,0 +1,150 @@
+package schema
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lithdew/bytesutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/go-store/store"
+)
+
+func testRegistry() Registry {
+	return Registry{
+		{
+			Version: 1,
+			Name:    "put",
+			Migrate: func(s store.Store, env Env) error {
+				if err := s.Put([]byte(".a1"), []byte("x")); err != nil {
+					return err
+				}
+				return s.Put([]byte(".a2"), []byte("y"))
+			},
+		},
+		{
+			Version: 2,
+			Name:    "rename",
+			Migrate: func(s store.Store, env Env) error {
+				return db.Iterate(s, []byte(".a"), func(key, value []byte) error {
+					if err := s.Delete(append([]byte(".a"), key...)); err != nil {
+						return err
+					}
+					return s.Put(append([]byte(".b"), key...), value)
+				})
+			},
+		},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	s := db.NewMemDB()
+	r := testRegistry()
+
+	results, err := r.Migrate(s, Env{}, true)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, 2, results[0].Writes)
+	require.Equal(t, 2, results[1].Writes)
+	require.Equal(t, 2, results[1].Deletes)
+
+	v, err := Version(s)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), v)
+	has, err := s.Has([]byte(".a1"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = r.Migrate(s, Env{}, false)
+	require.NoError(t, err)
+	v, err = Version(s)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), v)
+	got, err := s.Get([]byte(".b2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("y"), got)
+	has, err = s.Has([]byte(".a1"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	results, err = r.Migrate(s, Env{}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 0)
+
+	_, err = r[:1].Migrate(s, Env{}, false)
+	require.True(t, errors.Is(err, ErrSchemaTooNew))
+}
+
+func TestLegacyEvents(t *testing.T) {
+	const bank = "0x4C23a7E7E0e2c2fEfB0b4d8a6d4E6c2B0a1e7f1D"
+
+	s := db.NewMemDB()
+	r := pb.NewRepository(s)
+
+	// the events keyed by the uint64 id without the bank, as stored by the first version
+	put := func(prefix []byte, e pb.Event, id uint64) {
+		value, err := e.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, store.NewPrefixStore(s, prefix).Put(bytesutil.AppendUint64BE(nil, id), value))
+	}
+	put(pb.PREFIX_EVENT_ERC20, &pb.EventERC20Deposited{LegacyId: 1, Amount: "10"}, 1)
+	put(pb.PREFIX_EVENT_ERC20, &pb.EventERC20Deposited{Amount: "5"}, 0)
+	put(pb.PREFIX_EVENT_NFT, &pb.EventNFTDeposited{LegacyId: 1, LegacyTokenid: 100}, 1)
+	require.NoError(t, r.PutConfirmedBlock("", pb.BlockERC20, pb.ConfirmedBlock{Number: 7}))
+
+	_, err := Migrations.Migrate(s, Env{}, false)
+	require.True(t, errors.Is(err, ErrUnknownBank))
+
+	// the version 1 is committed before failed
+	results, err := Migrations.Migrate(s, Env{Bank: bank}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, 3, results[1].Deletes)
+
+	addr := common.HexToAddress(bank).Hex()
+	e := &pb.EventERC20Deposited{Bank: addr, Id: "1"}
+	require.NoError(t, r.GetEvent(e))
+	require.Equal(t, "10", e.Amount)
+	require.Equal(t, uint64(0), e.LegacyId)
+	zero := &pb.EventERC20Deposited{Bank: addr, Id: "0"}
+	require.NoError(t, r.GetEvent(zero))
+	require.Equal(t, "5", zero.Amount)
+	require.Error(t, r.GetEvent(&pb.EventNFTDeposited{Id: "1"}))
+	n := &pb.EventNFTDeposited{Bank: addr, Id: "1"}
+	require.NoError(t, r.GetEvent(n))
+	require.Equal(t, "100", n.Tokenid)
+
+	var count int
+	require.NoError(t, db.Iterate(s, pb.PREFIX_EVENT_ERC20, func(key, value []byte) error {
+		count++
+		return nil
+	}))
+	require.Equal(t, 2, count)
+
+	// re-run from the remains of the failed commit, the moved ones are written again
+	legacy := append(common.HexToAddress(bank).Bytes(), bytesutil.AppendUint64BE(nil, 1)...)
+	value, err := (&pb.EventNFTDeposited{LegacyId: 1, LegacyTokenid: 100, Bank: addr}).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, store.NewPrefixStore(s, pb.PREFIX_EVENT_NFT).Put(legacy, value))
+	require.NoError(t, SetVersion(s, 2))
+	_, err = Migrations.Migrate(s, Env{Bank: bank}, false)
+	require.NoError(t, err)
+	n = &pb.EventNFTDeposited{Bank: addr, Id: "1"}
+	require.NoError(t, r.GetEvent(n))
+	require.Equal(t, "100", n.Tokenid)
+	count = 0
+	require.NoError(t, db.Iterate(s, pb.PREFIX_EVENT_NFT, func(key, value []byte) error {
+		count++
+		return nil
+	}))
+	require.Equal(t, 1, count)
+
+	block, err := r.GetConfirmedBlock(addr, pb.BlockERC20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), block.Number)
+	block, err = r.GetConfirmedBlock("", pb.BlockERC20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), block.Number)
+}
@@ -0,0 +1,15 @@
+package service
+
+type Option interface {
+	Apply(*Server) error
+}
+
+type Token string
+
+func (t Token) Apply(s *Server) error {
+	s.token = string(t)
+	return nil
+}
+func WithToken(token string) Token {
+	return Token(token)
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/go-store/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	WatchBufferSize = 256
+)
+
+var (
+	_ pb.BridgeServiceServer = (*Server)(nil)
+
+	// the methods require the bearer token
+	adminMethods = map[string]bool{
+		"/tak1827.evmbridge.cli.BridgeService/SetPair":    true,
+		"/tak1827.evmbridge.cli.BridgeService/RetryEvent": true,
+	}
+)
+
+// Server serves the BridgeService over grpc
+type Server struct {
+	bridge *bridge.Bridge
+	token  string
+	logger zerolog.Logger
+
+	addr string
+	srv  *grpc.Server
+}
+
+func NewServer(addr string, b *bridge.Bridge, opts ...Option) *Server {
+	s := &Server{
+		bridge: b,
+		logger: log.GRPC(""),
+		addr:   addr,
+	}
+
+	for i := 0; i < len(opts); i++ {
+		opts[i].Apply(s)
+	}
+
+	s.srv = grpc.NewServer(grpc.UnaryInterceptor(s.authorize))
+	pb.RegisterBridgeServiceServer(s.srv, s)
+
+	return s
+}
+
+// Start listens the address, then serves in background
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.srv.Serve(ln); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.logger.Error().Err(err).Msg("grpc server stopped")
+		}
+	}()
+
+	s.logger.Info().Msgf("grpc server is listening on %s", ln.Addr())
+	return nil
+}
+
+func (s *Server) Close() {
+	s.srv.GracefulStop()
+}
+
+func (s *Server) GetStatus(ctx context.Context, req *pb.GetStatusRequest) (*pb.GetStatusResponse, error) {
+	st, err := s.bridge.Status()
+	if err != nil {
+		return nil, s.toStatusErr(err)
+	}
+
+	banks := make([]pb.BankStatus, len(st.Banks))
+	for i, bk := range st.Banks {
+		banks[i] = pb.BankStatus{Address: bk.Address, ConfirmedBlockErc20: bk.ConfirmedBlockERC20, ConfirmedBlockNft: bk.ConfirmedBlockNFT}
+	}
+
+	return &pb.GetStatusResponse{
+		ConfirmedBlockErc20: st.ConfirmedBlockERC20,
+		ConfirmedBlockNft:   st.ConfirmedBlockNFT,
+		InflightErc20:       uint32(st.InflightERC20),
+		InflightNft:         uint32(st.InflightNFT),
+		ConfirmerQueue:      uint32(st.ConfirmerQueue),
+		RetryQueue:          uint32(st.RetryQueue),
+		Signer:              st.Signer,
+		Nonce:               st.Nonce,
+		Balance:             st.Balance,
+		MintingPaused:       st.MintingPaused,
+		Banks:               banks,
+	}, nil
+}
+
+func (s *Server) ListPairs(ctx context.Context, req *pb.ListPairsRequest) (*pb.ListPairsResponse, error) {
+	pairs, err := s.bridge.Repo.ListPairs()
+	if err != nil {
+		return nil, s.toStatusErr(err)
+	}
+	return &pb.ListPairsResponse{Pairs: pairs}, nil
+}
+
+func (s *Server) GetPair(ctx context.Context, req *pb.GetPairRequest) (*pb.Pair, error) {
+	pair, err := bridge.GetPair(s.bridge.Repo, req.GetInaddr())
+	if err != nil {
+		return nil, s.toStatusErr(err)
+	}
+	return &pair, nil
+}
+
+func (s *Server) SetPair(ctx context.Context, req *pb.SetPairRequest) (*pb.Pair, error) {
+	checker := s.bridge.PairChecker()
+	if req.GetSkipChecks() {
+		s.logger.Warn().Msgf("the on-chain checks are skipped, in-addr: %s", req.GetInaddr())
+		checker = bridge.SkipPairChecks
+	}
+
+	pair, err := bridge.SetPair(ctx, s.bridge.Repo, bridge.PairSpec{
+		Inaddr:  req.GetInaddr(),
+		Outaddr: req.GetOutaddr(),
+		Wrapped: req.GetWrapped(),
+		URIFrom: req.GetUriFrom(),
+		URITo:   req.GetUriTo(),
+	}, checker)
+	if err != nil {
+		return nil, s.toStatusErr(err)
+	}
+
+	s.logger.Info().Msgf("pair is set by grpc, pair: %v", pair)
+	return &pair, nil
+}
+
+func (s *Server) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	var st *pb.EventStatus
+	if req.GetFilterStatus() {
+		v := req.GetStatus()
+		st = &v
+	}
+
+	events, err := bridge.ListEvents(s.bridge.Repo, req.GetType(), st)
+	if err != nil {
+		return nil, s.toStatusErr(err)
+	}
+
+	res := &pb.ListEventsResponse{Events: make([]pb.AnyEvent, len(events))}
+	for i := range events {
+		res.Events[i] = pb.ToAnyEvent(events[i])
+	}
+	return res, nil
+}
+
+func (s *Server) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.AnyEvent, error) {
+	e, err := s.bridge.NewEvent(req.GetType(), req.GetBank(), req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = s.bridge.Repo.GetEvent(e); err != nil {
+		return nil, s.toStatusErr(err)
+	}
+
+	res := pb.ToAnyEvent(e)
+	return &res, nil
+}
+
+func (s *Server) RetryEvent(ctx context.Context, req *pb.RetryEventRequest) (*pb.RetryEventResponse, error) {
+	e, err := s.bridge.NewEvent(req.GetType(), req.GetBank(), req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hash, err := s.bridge.RetryEvent(ctx, e)
+	if err != nil {
+		return nil, s.toStatusErr(err)
+	}
+
+	s.logger.Info().Msgf("event is retried by grpc, hash: %s, event: %v", hash, e)
+	return &pb.RetryEventResponse{Hash: hash}, nil
+}
+
+func (s *Server) WatchEvents(req *pb.WatchEventsRequest, stream pb.BridgeService_WatchEventsServer) error {
+	var (
+		ch     = make(chan bridge.Notification, WatchBufferSize)
+		lagged = make(chan struct{})
+		once   sync.Once
+	)
+
+	unsubscribe := s.bridge.Subscribe(func(n bridge.Notification) {
+		if req.GetType() != "" && req.GetType() != n.Event.Type() {
+			return
+		}
+		select {
+		case ch <- n:
+		default:
+			// never block the bridge, drop the slow subscriber instead
+			once.Do(func() { close(lagged) })
+		}
+	})
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-lagged:
+			return status.Error(codes.ResourceExhausted, "the subscriber is too slow, updates are dropped")
+		case n := <-ch:
+			if err := stream.Send(toEventUpdate(n)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toEventUpdate(n bridge.Notification) *pb.EventUpdate {
+	u := &pb.EventUpdate{
+		Stage:     stages[n.Stage],
+		Hash:      n.Hash,
+		Event:     pb.ToAnyEvent(n.Event),
+		CreatedAt: n.CreatedAt,
+	}
+	if n.Err != nil {
+		u.Error = n.Err.Error()
+	}
+	return u
+}
+
+var stages = map[bridge.Stage]pb.EventUpdate_Stage{
+	bridge.StageDetected:  pb.EventUpdate_DETECTED,
+	bridge.StageSent:      pb.EventUpdate_SENT,
+	bridge.StageRetried:   pb.EventUpdate_RETRIED,
+	bridge.StageSucceeded: pb.EventUpdate_SUCCEEDED,
+	bridge.StageFailed:    pb.EventUpdate_FAILED,
+	bridge.StagePaused:    pb.EventUpdate_PAUSED,
+}
+
+// authorize checks the bearer token of the admin methods
+func (s *Server) authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !adminMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	if s.token == "" {
+		return nil, status.Error(codes.PermissionDenied, "admin service is disabled, set the api token to enable")
+	}
+
+	var given string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("authorization")) > 0 {
+		given = strings.TrimPrefix(md.Get("authorization")[0], "Bearer ")
+	}
+	if subtle.ConstantTimeCompare([]byte(given), []byte(s.token)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	return handler(ctx, req)
+}
+
+// toStatusErr maps the bridge errors to the grpc status
+func (s *Server) toStatusErr(err error) error {
+	switch {
+	case errors.Is(err, store.ErrNotFound), errors.Is(err, bridge.ErrEventNotFound), errors.Is(err, bridge.ErrPairNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, bridge.ErrInvalidAddress), errors.Is(err, bridge.ErrUnknownEventType), errors.Is(err, bridge.ErrNotContract),
+		errors.Is(err, bridge.ErrNotWhitelisted), errors.Is(err, bridge.ErrMintUnsupported), errors.Is(err, bridge.ErrMintDenied):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, bridge.ErrEventSucceeded), errors.Is(err, bridge.ErrEventInflight), errors.Is(err, bridge.ErrPairDisabled),
+		errors.Is(err, bridge.ErrLowFunds), errors.Is(err, bridge.ErrNoPairChecker):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, db.ErrIterationUnsupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	default:
+		s.logger.Warn().Err(err).Msg("failed to handle grpc request")
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/evm-bridge/cli/simulated"
+	"github.com/tak1827/transaction-confirmer/confirm"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	AdminToken = "secret"
+)
+
+// newTestServer serves the bridge of the simulated chain over the in memory listener
+func newTestServer(t *testing.T, dialOpts ...grpc.DialOption) (*simulated.Harness, *bridge.Bridge, pb.BridgeServiceClient) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := simulated.NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 1024, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), bridge.WithBridgeContract(h.Bridge))
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+
+	var (
+		s   = NewServer("bufconn", b, WithToken(AdminToken))
+		lis = bufconn.Listen(1 << 20)
+	)
+	go s.srv.Serve(lis)
+
+	conn, err := grpc.DialContext(ctx, "bufconn", append([]grpc.DialOption{
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	}, dialOpts...)...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		s.Close()
+		b.Close(cancel, 0, false)
+	})
+
+	return h, b, pb.NewBridgeServiceClient(conn)
+}
+
+func TestSetPair(t *testing.T) {
+	h, b, cli := newTestServer(t)
+
+	var (
+		ctx   = context.Background()
+		admin = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+AdminToken)
+		req   = &pb.SetPairRequest{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex()}
+	)
+
+	_, err := cli.SetPair(ctx, req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = cli.SetPair(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer wrong"), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	for _, tc := range []struct {
+		req  *pb.SetPairRequest
+		code codes.Code
+	}{
+		{&pb.SetPairRequest{Inaddr: "0x01", Outaddr: h.ERC20Out.Hex()}, codes.InvalidArgument},
+		// not whitelisted yet
+		{req, codes.InvalidArgument},
+		{&pb.SetPairRequest{Inaddr: h.ERC20In.Hex(), Outaddr: h.Bank.Hex()}, codes.InvalidArgument},
+	} {
+		_, err = cli.SetPair(admin, tc.req)
+		require.Equal(t, tc.code, status.Code(err), "req: %v", tc.req)
+	}
+	pairs, err := b.Repo.ListPairs()
+	require.NoError(t, err)
+	require.Empty(t, pairs)
+
+	require.NoError(t, h.Whitelist(ctx))
+	pair, err := cli.SetPair(admin, req)
+	require.NoError(t, err)
+	require.Equal(t, h.ERC20Out.Hex(), pair.Outaddr)
+
+	// the read methods are open
+	got, err := cli.GetPair(ctx, &pb.GetPairRequest{Inaddr: h.ERC20In.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, pair.Inaddr, got.Inaddr)
+
+	// registered without the checks explicitly
+	_, err = cli.SetPair(admin, &pb.SetPairRequest{Inaddr: h.NFTIn.Hex(), Outaddr: h.Bank.Hex(), SkipChecks: true})
+	require.NoError(t, err)
+	pairs, err = b.Repo.ListPairs()
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+}
+
+func TestWatchEventsSlow(t *testing.T) {
+	// the fixed windows disable the dynamic ones, so that the server blocks soon while the client never receives
+	h, b, cli := newTestServer(t, grpc.WithInitialWindowSize(1<<16), grpc.WithInitialConnWindowSize(1<<16))
+
+	ctx := context.Background()
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	slow, err := cli.WatchEvents(ctx, &pb.WatchEventsRequest{})
+	require.NoError(t, err)
+	// the nft only, never updated
+	idle, err := cli.WatchEvents(ctx, &pb.WatchEventsRequest{Type: pb.EventTypeNFT})
+	require.NoError(t, err)
+
+	// the updates overflowing the buffer and the windows
+	bk := b.Banks[0]
+	for i := 0; i < 2; i++ {
+		for j := 0; j < WatchBufferSize; j++ {
+			require.NoError(t, h.DepositERC20(ctx, 1))
+		}
+		bk.ConfirmedBlockERC20.Number, err = b.FetchERC20(ctx, bk)
+		require.NoError(t, err)
+	}
+
+	// the buffered updates are received, then the stream is closed
+	var received int
+	for {
+		_, err = slow.Recv()
+		if err != nil {
+			break
+		}
+		received++
+	}
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Greater(t, received, WatchBufferSize)
+
+	// the other subscriber is kept
+	idleErr := make(chan error, 1)
+	go func() {
+		_, err := idle.Recv()
+		idleErr <- err
+	}()
+	select {
+	case err = <-idleErr:
+		t.Fatalf("the idle subscriber is closed, err: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
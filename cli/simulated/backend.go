@@ -0,0 +1,171 @@
+package simulated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tak1827/evm-bridge/cli/client"
+)
+
+const (
+	GasLimit = uint64(30000000)
+)
+
+var (
+	_ client.Backend = (*Backend)(nil)
+)
+
+// the percent of the gas price the replacing tx has to be higher by, same as geth
+const priceBump = 10
+
+var (
+	ErrNonceTooLow  = errors.New("nonce too low")
+	ErrUnderpriced  = errors.New("replacement transaction underpriced")
+	simulatedSigner = types.LatestSignerForChainID(big.NewInt(1337))
+)
+
+// Backend is the in memory chain mining the tx on send, like ganache.
+// the invalid tx is returned as the error instead of the panic of the simulated backend
+type Backend struct {
+	*backends.SimulatedBackend
+
+	mu sync.Mutex
+	// the txs kept in the pool while held, by the sender and the nonce
+	held bool
+	pool map[common.Address]map[uint64]*types.Transaction
+}
+
+func NewBackend(alloc core.GenesisAlloc) *Backend {
+	return &Backend{
+		SimulatedBackend: backends.NewSimulatedBackend(alloc, GasLimit),
+		pool:             make(map[common.Address]map[uint64]*types.Transaction),
+	}
+}
+
+// Hold keeps the sent txs in the pool until `Mine`, like the node under the congestion.
+// the pooled tx is replaced by the tx of the same nonce with the higher gas price
+func (b *Backend) Hold(held bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.held = held
+}
+
+// SendTransaction mines the block of the tx at once, or pools it while held
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) (err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.held {
+		return b.pooltx(ctx, tx)
+	}
+
+	if err = b.sendtx(ctx, tx); err != nil {
+		return
+	}
+	b.SimulatedBackend.Commit()
+	return
+}
+
+// PendingNonceAt counts the pooled txs following the chain nonce
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	nonce, err := b.SimulatedBackend.PendingNonceAt(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+	for b.pool[account][nonce] != nil {
+		nonce++
+	}
+	return nonce, nil
+}
+
+// TransactionReceipt returns `ethereum.NotFound` for the unknown tx as the node does
+func (b *Backend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, err := b.SimulatedBackend.TransactionReceipt(ctx, hash)
+	if err == nil && receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return receipt, err
+}
+
+// Mine appends the blocks, advancing the confirmations. the pooled txs executable are mined in the first one
+func (b *Backend) Mine(blocks int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if blocks > 0 {
+		b.flush()
+	}
+	for i := 0; i < blocks; i++ {
+		b.SimulatedBackend.Commit()
+	}
+}
+
+func (b *Backend) sendtx(ctx context.Context, tx *types.Transaction) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	return b.SimulatedBackend.SendTransaction(ctx, tx)
+}
+
+func (b *Backend) pooltx(ctx context.Context, tx *types.Transaction) error {
+	from, err := types.Sender(simulatedSigner, tx)
+	if err != nil {
+		return err
+	}
+	nonce, err := b.SimulatedBackend.PendingNonceAt(ctx, from)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() < nonce {
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", ErrNonceTooLow, from.Hex(), tx.Nonce(), nonce)
+	}
+
+	if b.pool[from] == nil {
+		b.pool[from] = make(map[uint64]*types.Transaction)
+	}
+	if old := b.pool[from][tx.Nonce()]; old != nil {
+		min := new(big.Int).Mul(old.GasPrice(), big.NewInt(100+priceBump))
+		if min.Div(min, big.NewInt(100)); tx.GasPrice().Cmp(min) < 0 {
+			return ErrUnderpriced
+		}
+	}
+	b.pool[from][tx.Nonce()] = tx
+	return nil
+}
+
+// flush sends the pooled txs in the nonce order, the ones behind the gap are kept
+func (b *Backend) flush() {
+	for from, txs := range b.pool {
+		nonce, err := b.SimulatedBackend.PendingNonceAt(context.Background(), from)
+		if err != nil {
+			continue
+		}
+		for tx := txs[nonce]; tx != nil; tx = txs[nonce] {
+			delete(txs, nonce)
+			if err := b.sendtx(context.Background(), tx); err != nil {
+				break
+			}
+			nonce++
+		}
+		for n := range txs {
+			if n < nonce {
+				delete(txs, n)
+			}
+		}
+	}
+}
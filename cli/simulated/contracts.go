@@ -0,0 +1,273 @@
+package simulated
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// the stand-ins of the contracts, implementing only the abi the relayer depends on without the access control.
+// written in the evm assembly, since the solidity compiler is not available in the go build
+var (
+	// the deposits are logged with the ids counted from 0, same as `Bank.sol`
+	BankCode = mustAssemble(dispatch(
+		method{"depositERC20(address,address,uint256)", "deposit_erc20"},
+		method{"depositNFT(address,address,uint256)", "deposit_nft"},
+	) + fmt.Sprintf(`
+deposit_erc20:
+	PUSH 0x40
+	PUSH 0x24
+	PUSH 0
+	CALLDATACOPY
+	PUSH 0x04
+	CALLDATALOAD
+	PUSH 1
+	SLOAD
+	DUP1
+	PUSH 1
+	ADD
+	PUSH 1
+	SSTORE
+	PUSH %s
+	PUSH 0x40
+	PUSH 0
+	LOG3
+	STOP
+deposit_nft:
+	PUSH 0x40
+	PUSH 0x24
+	PUSH 0
+	CALLDATACOPY
+	PUSH 0x04
+	CALLDATALOAD
+	PUSH 2
+	SLOAD
+	DUP1
+	PUSH 1
+	ADD
+	PUSH 2
+	SSTORE
+	PUSH %s
+	PUSH 0x40
+	PUSH 0
+	LOG3
+	STOP
+`, topic("ERC20Deposited(uint256,address,address,uint256)"), topic("NFTDeposited(uint256,address,address,uint256)")))
+
+	// the whitelists of `Bridge.sol`, the count and the items are stored by the kind
+	BridgeCode = mustAssemble(dispatch(
+		method{"addERC20Whitelist(address)", "add_erc20"},
+		method{"countERC20Whitelist()", "count_erc20"},
+		method{"getERC20Whitelist(uint256)", "get_erc20"},
+		method{"addNFTWhitelist(address)", "add_nft"},
+		method{"countNFTWhitelist()", "count_nft"},
+		method{"getNFTWhitelist(uint256)", "get_nft"},
+	) + whitelist("erc20", 0x10, "0x100000000000000000000000000000000") +
+		whitelist("nft", 0x20, "0x200000000000000000000000000000000") + returnWord)
+
+	// the erc20 minted by anyone, the balance is stored by the account
+	WrappedTokenCode = mustAssemble(dispatch(
+		method{"mint(address,uint256)", "mint"},
+		method{"balanceOf(address)", "balance_of"},
+	) + `
+mint:
+	PUSH 0x24
+	CALLDATALOAD
+	PUSH 0x04
+	CALLDATALOAD
+	DUP1
+	SLOAD
+	DUP3
+	ADD
+	SWAP1
+	SSTORE
+	STOP
+balance_of:
+	PUSH 0x04
+	CALLDATALOAD
+	SLOAD
+	JUMP @return_word
+` + returnWord)
+
+	// the erc20 whose mint is reverted for anyone, as the minter role is not granted to the relayer
+	DeniedTokenCode = mustAssemble(dispatch(
+		method{"mint(address,uint256)", "revert"},
+	))
+
+	// the nft minted by anyone, the owner is stored by the token id. the minted id is reverted.
+	// the token uri of every id is `MockTokenURI`, the hash of the minted uri is stored apart from the owner
+	MockNFTCode = mustAssemble(dispatch(
+		method{"safeMint(uint256,address,string)", "safe_mint"},
+		method{"ownerOf(uint256)", "owner_of"},
+		method{"tokenURI(uint256)", "token_uri"},
+		method{"mintedURIHash(uint256)", "minted_uri_hash"},
+	) + fmt.Sprintf(`
+safe_mint:
+	PUSH 0x04
+	CALLDATALOAD
+	DUP1
+	SLOAD
+	JUMPI @revert
+	PUSH 0x24
+	CALLDATALOAD
+	SWAP1
+	SSTORE
+	PUSH 0x44
+	CALLDATALOAD
+	PUSH 0x04
+	ADD
+	DUP1
+	CALLDATALOAD
+	SWAP1
+	PUSH 0x20
+	ADD
+	DUP2
+	SWAP1
+	PUSH 0
+	CALLDATACOPY
+	PUSH 0
+	KECCAK256
+	PUSH 0x04
+	CALLDATALOAD
+	PUSH %[1]s
+	ADD
+	SSTORE
+	STOP
+token_uri:
+	PUSH 0x20
+	PUSH 0
+	MSTORE
+	PUSH %[2]d
+	PUSH 0x20
+	MSTORE
+	PUSH 0x%[3]s
+	PUSH 0x40
+	MSTORE
+	PUSH 0x60
+	PUSH 0
+	RETURN
+minted_uri_hash:
+	PUSH 0x04
+	CALLDATALOAD
+	PUSH %[1]s
+	ADD
+	SLOAD
+	JUMP @return_word
+owner_of:
+	PUSH 0x04
+	CALLDATALOAD
+	SLOAD
+	DUP1
+	ISZERO
+	JUMPI @revert
+	JUMP @return_word
+`, "0x100000000000000000000000000000000", len(MockTokenURI), hex.EncodeToString([]byte(MockTokenURI+strings.Repeat("\x00", 32-len(MockTokenURI))))) + returnWord)
+)
+
+// the token uri of the mock nft, not longer than the word
+const MockTokenURI = "ipfs://mock"
+
+// returnWord returns the top of the stack as the 32 bytes word
+const returnWord = `
+return_word:
+	PUSH 0
+	MSTORE
+	PUSH 0x20
+	PUSH 0
+	RETURN
+`
+
+type method struct {
+	signature string
+	label     string
+}
+
+// dispatch jumps to the label of the called method, the unknown one is reverted
+func dispatch(methods ...method) string {
+	var b strings.Builder
+	b.WriteString(`
+	PUSH 0
+	CALLDATALOAD
+	PUSH 0xe0
+	SHR
+`)
+	for _, m := range methods {
+		fmt.Fprintf(&b, `	DUP1
+	PUSH 0x%x
+	EQ
+	JUMPI @%s
+`, crypto.Keccak256([]byte(m.signature))[:4], m.label)
+	}
+	b.WriteString(`revert:
+	PUSH 0
+	DUP1
+	REVERT
+`)
+	return b.String()
+}
+
+func whitelist(kind string, countSlot int, base string) string {
+	return fmt.Sprintf(`
+add_%[1]s:
+	PUSH 0x04
+	CALLDATALOAD
+	PUSH %[2]d
+	SLOAD
+	DUP1
+	PUSH %[3]s
+	ADD
+	DUP3
+	SWAP1
+	SSTORE
+	PUSH 1
+	ADD
+	PUSH %[2]d
+	SSTORE
+	STOP
+count_%[1]s:
+	PUSH %[2]d
+	SLOAD
+	JUMP @return_word
+get_%[1]s:
+	PUSH 0x04
+	CALLDATALOAD
+	PUSH %[3]s
+	ADD
+	SLOAD
+	JUMP @return_word
+`, kind, countSlot, base)
+}
+
+func topic(event string) string {
+	return crypto.Keccak256Hash([]byte(event)).Hex()
+}
+
+// mustAssemble returns the creation code deploying the runtime code of the source
+func mustAssemble(src string) []byte {
+	c := asm.NewCompiler(false)
+	c.Feed(asm.Lex([]byte(src), false))
+	out, errs := c.Compile()
+	if len(errs) != 0 {
+		panic(fmt.Sprintf("failed to assemble: %v", errs))
+	}
+	runtime, err := hex.DecodeString(out)
+	if err != nil {
+		panic(err)
+	}
+
+	// copy the runtime code placed after this 12 bytes, then return it
+	n := len(runtime)
+	init := []byte{
+		0x61, byte(n >> 8), byte(n), // PUSH2 n
+		0x80,       // DUP1
+		0x60, 0x0c, // PUSH1 12
+		0x60, 0x00, // PUSH1 0
+		0x39,       // CODECOPY
+		0x60, 0x00, // PUSH1 0
+		0xf3, // RETURN
+	}
+	return append(init, runtime...)
+}
@@ -0,0 +1,213 @@
+package simulated
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tak1827/evm-bridge/cli/client"
+)
+
+const (
+	// above the base fee of the simulated chain
+	GasPrice = int64(10000000000) // 10 gwei
+)
+
+var (
+	// the balance of the accounts at the genesis, 1000 ether
+	InitialBalance = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1000000000000000000))
+)
+
+// Harness is the chain deploying the stand-ins of the contracts, shared by the in and the out chain.
+// the user deposits to the bank, then the relayer mints on the out tokens
+type Harness struct {
+	*Backend
+
+	Relayer *ecdsa.PrivateKey
+	User    *ecdsa.PrivateKey
+
+	Bank     common.Address
+	Bridge   common.Address
+	ERC20In  common.Address
+	ERC20Out common.Address
+	NFTIn    common.Address
+	NFTOut   common.Address
+}
+
+func NewHarness() (h *Harness, err error) {
+	h = &Harness{}
+	if h.Relayer, err = crypto.GenerateKey(); err != nil {
+		return
+	}
+	if h.User, err = crypto.GenerateKey(); err != nil {
+		return
+	}
+
+	h.Backend = NewBackend(core.GenesisAlloc{
+		crypto.PubkeyToAddress(h.Relayer.PublicKey): {Balance: InitialBalance},
+		crypto.PubkeyToAddress(h.User.PublicKey):    {Balance: InitialBalance},
+	})
+
+	for _, d := range []struct {
+		addr *common.Address
+		abi  string
+		code []byte
+	}{
+		{&h.Bank, client.IBankABI, BankCode},
+		{&h.Bridge, client.IBridgeWhitelistABI, BridgeCode},
+		{&h.ERC20In, client.IERC20ABI, WrappedTokenCode},
+		{&h.ERC20Out, client.IERC20ABI, WrappedTokenCode},
+		{&h.NFTIn, client.IERC721ABI, MockNFTCode},
+		{&h.NFTOut, client.IERC721ABI, MockNFTCode},
+	} {
+		if *d.addr, err = h.deploy(d.abi, d.code); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Client returns the client of the out chain
+func (h *Harness) Client(opts ...client.Option) (client.Client, error) {
+	return client.NewClientWithBackend(h.Backend, h.Bank.Hex(), append([]client.Option{client.WithGasPrice(GasPrice)}, opts...)...)
+}
+
+// ReadClient returns the client of the in chain
+func (h *Harness) ReadClient() (client.ReadClient, error) {
+	return client.NewReadClientWithBackend(h.Backend, h.Bank.Hex())
+}
+
+// RelayerKey returns the private key of the relayer in hex, as `BRIDGECLI_PRI_KEY`
+func (h *Harness) RelayerKey() string {
+	return hex.EncodeToString(crypto.FromECDSA(h.Relayer))
+}
+
+func (h *Harness) UserAddress() common.Address {
+	return crypto.PubkeyToAddress(h.User.PublicKey)
+}
+
+// DeployBank deploys another bank, whose ids are counted apart from the first one
+func (h *Harness) DeployBank() (common.Address, error) {
+	return h.deploy(client.IBankABI, BankCode)
+}
+
+// DeployDeniedToken deploys the erc20 not allowing the relayer to mint
+func (h *Harness) DeployDeniedToken() (common.Address, error) {
+	return h.deploy(client.IERC20ABI, DeniedTokenCode)
+}
+
+// DepositERC20 deposits the amount of the erc20 in by the user
+func (h *Harness) DepositERC20(ctx context.Context, amount int64) error {
+	return h.DepositERC20To(ctx, h.Bank, amount)
+}
+
+// DepositERC20To deposits the amount of the erc20 in to the bank
+func (h *Harness) DepositERC20To(ctx context.Context, bank common.Address, amount int64) error {
+	c, err := client.NewClientWithBackend(h.Backend, bank.Hex(), client.WithGasPrice(GasPrice))
+	if err != nil {
+		return err
+	}
+	_, err = c.DepositERC20(ctx, h.User, nil, h.ERC20In, amount)
+	return err
+}
+
+// DepositNFT deposits the token of the nft in by the user
+func (h *Harness) DepositNFT(ctx context.Context, tokenid *big.Int) error {
+	c, err := h.Client()
+	if err != nil {
+		return err
+	}
+	_, err = c.DepositNFT(ctx, h.User, nil, h.NFTIn, tokenid)
+	return err
+}
+
+// Whitelist adds the in tokens to the whitelist of the bridge
+func (h *Harness) Whitelist(ctx context.Context) error {
+	for _, w := range []struct {
+		method string
+		token  common.Address
+	}{
+		{"addERC20Whitelist", h.ERC20In},
+		{"addNFTWhitelist", h.NFTIn},
+	} {
+		if err := h.transact(ctx, h.Bridge, `[{"inputs":[{"name":"token","type":"address"}],"name":"`+w.method+`","outputs":[],"stateMutability":"nonpayable","type":"function"}]`, w.method, w.token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BalanceOf returns the erc20 balance of the account
+func (h *Harness) BalanceOf(ctx context.Context, token, account common.Address) (*big.Int, error) {
+	out, err := h.call(ctx, token, client.IERC20ABI, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// OwnerOf returns the owner of the nft, reverted when not minted
+func (h *Harness) OwnerOf(ctx context.Context, token common.Address, tokenid *big.Int) (common.Address, error) {
+	out, err := h.call(ctx, token, client.IERC721ABI, "ownerOf", tokenid)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return out[0].(common.Address), nil
+}
+
+// MintedURIHash returns the keccak256 of the token uri passed to the mint, zero when not minted
+func (h *Harness) MintedURIHash(ctx context.Context, token common.Address, tokenid *big.Int) (common.Hash, error) {
+	out, err := h.call(ctx, token, `[{"inputs":[{"name":"tokenId","type":"uint256"}],"name":"mintedURIHash","outputs":[{"name":"","type":"bytes32"}],"stateMutability":"view","type":"function"}]`, "mintedURIHash", tokenid)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return out[0].([32]byte), nil
+}
+
+func (h *Harness) deploy(abiJSON string, code []byte) (common.Address, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, _, _, err := bind.DeployContract(h.transactOpts(context.Background()), parsed, code, h.Backend)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy: %w", err)
+	}
+	return addr, nil
+}
+
+func (h *Harness) transact(ctx context.Context, to common.Address, abiJSON, method string, args ...interface{}) error {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return err
+	}
+	_, err = bind.NewBoundContract(to, parsed, h.Backend, h.Backend, h.Backend).Transact(h.transactOpts(ctx), method, args...)
+	return err
+}
+
+func (h *Harness) call(ctx context.Context, to common.Address, abiJSON, method string, args ...interface{}) ([]interface{}, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+	var out []interface{}
+	err = bind.NewBoundContract(to, parsed, h.Backend, h.Backend, h.Backend).Call(&bind.CallOpts{Context: ctx}, &out, method, args...)
+	return out, err
+}
+
+// transactOpts signs by the user, not to consume the nonce of the relayer
+func (h *Harness) transactOpts(ctx context.Context) *bind.TransactOpts {
+	auth := bind.NewKeyedTransactor(h.User)
+	auth.GasPrice = big.NewInt(GasPrice)
+	auth.Context = ctx
+	return auth
+}
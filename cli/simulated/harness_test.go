@@ -0,0 +1,657 @@
+package simulated
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/client"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/metrics"
+	"github.com/tak1827/evm-bridge/cli/pb"
+	"github.com/tak1827/transaction-confirmer/confirm"
+)
+
+func TestDepositMint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.NFTIn.Hex(), Outaddr: h.NFTOut.Hex(), Intype: pb.Pair_ORIGINAL, UriFrom: "ipfs://", UriTo: "https://ipfs.io/ipfs/"}))
+
+	for i := int64(0); i < 3; i++ {
+		require.NoError(t, h.DepositERC20(ctx, 10))
+		require.NoError(t, h.DepositNFT(ctx, big.NewInt(100+i)))
+	}
+
+	bk := b.Banks[0]
+	bk.ConfirmedBlockERC20.Number, err = b.FetchERC20(ctx, bk)
+	require.NoError(t, err)
+	bk.ConfirmedBlockNFT.Number, err = b.FetchNFT(ctx, bk)
+	require.NoError(t, err)
+
+	// the mint txs are confirmed after the next block
+	h.Mine(1)
+	require.Eventually(t, func() bool {
+		st, err := b.Status()
+		return err == nil && st.InflightERC20 == 0 && st.InflightNFT == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	balance, err := h.BalanceOf(ctx, h.ERC20Out, h.UserAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(30), balance.Int64())
+	for i := int64(0); i < 3; i++ {
+		owner, err := h.OwnerOf(ctx, h.NFTOut, big.NewInt(100+i))
+		require.NoError(t, err)
+		require.Equal(t, h.UserAddress(), owner)
+
+		e := &pb.EventNFTDeposited{Bank: h.Bank.Hex(), Id: strconv.FormatInt(i, 10)}
+		require.NoError(t, b.Repo.GetEvent(e))
+		require.Equal(t, pb.EventStatus_SUCCEEDED, e.Status)
+		require.Equal(t, MockTokenURI, e.TokenUri)
+
+		// minted with the uri rewritten by the pair
+		hash, err := h.MintedURIHash(ctx, h.NFTOut, big.NewInt(100+i))
+		require.NoError(t, err)
+		require.Equal(t, crypto.Keccak256Hash([]byte("https://ipfs.io/ipfs/mock")), hash)
+	}
+
+	// the same range is not minted twice
+	_, err = b.FetchERC20(ctx, bk)
+	require.NoError(t, err)
+	balance, err = h.BalanceOf(ctx, h.ERC20Out, h.UserAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(30), balance.Int64())
+}
+
+func TestContracts(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	require.NoError(t, h.Whitelist(ctx))
+	erc20, nft, err := rc.Whitelisted(ctx, h.Bridge, h.ERC20In)
+	require.NoError(t, err)
+	require.True(t, erc20)
+	require.False(t, nft)
+	erc20, nft, err = rc.Whitelisted(ctx, h.Bridge, h.NFTIn)
+	require.NoError(t, err)
+	require.False(t, erc20)
+	require.True(t, nft)
+
+	code, err := c.CodeAt(ctx, h.ERC20Out)
+	require.NoError(t, err)
+	require.True(t, c.HasERC20Mint(code))
+	require.False(t, c.HasNFTMint(code))
+	code, err = c.CodeAt(ctx, h.NFTOut)
+	require.NoError(t, err)
+	require.True(t, c.HasNFTMint(code))
+
+	// the mint is simulated without the tx
+	require.NoError(t, c.CallNFTMint(ctx, h.UserAddress(), h.NFTOut))
+	require.NoError(t, c.CallERC20Mint(ctx, h.UserAddress(), h.ERC20Out))
+	_, err = h.OwnerOf(ctx, h.NFTOut, big.NewInt(1))
+	require.Error(t, err)
+
+	uri, err := rc.TokenURI(ctx, h.NFTIn, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, MockTokenURI, uri)
+	_, err = rc.TokenURI(ctx, h.ERC20In, big.NewInt(1))
+	require.ErrorIs(t, err, client.ErrNoTokenURI)
+}
+
+func TestDryRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), bridge.WithDryRun(true))
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+	require.True(t, b.DryRun())
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.NFTIn.Hex(), Outaddr: h.NFTOut.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	for i := int64(0); i < 2; i++ {
+		require.NoError(t, h.DepositERC20(ctx, 10))
+		require.NoError(t, h.DepositNFT(ctx, big.NewInt(100+i)))
+	}
+	require.NoError(t, h.DepositERC20(ctx, 10))
+
+	_, err = b.FetchERC20(ctx, b.Banks[0])
+	require.NoError(t, err)
+	_, err = b.FetchNFT(ctx, b.Banks[0])
+	require.NoError(t, err)
+
+	sum := b.DryRunSummary()
+	require.Equal(t, 3, sum.Counts[pb.EventTypeERC20])
+	require.Equal(t, 2, sum.Counts[pb.EventTypeNFT])
+	require.Equal(t, 1, sum.Fee.Sign())
+
+	// nothing is sent, nor stored
+	h.Mine(1)
+	balance, err := h.BalanceOf(ctx, h.ERC20Out, h.UserAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), balance.Int64())
+	_, err = h.OwnerOf(ctx, h.NFTOut, big.NewInt(100))
+	require.Error(t, err)
+	nonce, err := c.NonceAt(ctx, crypto.PubkeyToAddress(h.Relayer.PublicKey))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), nonce)
+
+	st, err := b.Status()
+	require.NoError(t, err)
+	require.Equal(t, 0, st.InflightERC20+st.InflightNFT)
+	require.Equal(t, uint64(0), st.Nonce)
+	require.Error(t, b.Repo.GetEvent(&pb.EventERC20Deposited{Bank: h.Bank.Hex(), Id: "0"}))
+}
+
+func TestReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, h.DepositERC20(ctx, 10))
+	}
+	latest, err := rc.LatestBlockNumber(ctx)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, b.Replay(ctx, pb.EventTypeERC20, 2, 1), bridge.ErrInvalidRange)
+	require.ErrorIs(t, b.Replay(ctx, pb.EventTypeERC20, 0, latest+1), bridge.ErrInvalidRange)
+	require.ErrorIs(t, b.Replay(ctx, "coin", 0, latest), bridge.ErrUnknownEventType)
+
+	minted := func(amount int64) {
+		h.Mine(1)
+		require.Eventually(t, func() bool {
+			st, err := b.Status()
+			return err == nil && st.InflightERC20 == 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		balance, err := h.BalanceOf(ctx, h.ERC20Out, h.UserAddress())
+		require.NoError(t, err)
+		require.Equal(t, amount, balance.Int64())
+	}
+
+	// the last deposit only
+	require.NoError(t, b.Replay(ctx, pb.EventTypeERC20, latest, latest))
+	minted(10)
+
+	// the succeeded one is skipped
+	require.NoError(t, b.Replay(ctx, pb.EventTypeERC20, 0, latest))
+	minted(30)
+	require.NoError(t, b.Replay(ctx, pb.EventTypeERC20, 0, latest))
+	minted(30)
+
+	// the cursor is left as is
+	block, err := bridge.GetCursor(b.Repo, h.Bank.Hex(), pb.EventTypeERC20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), block.Number)
+
+	prev, err := bridge.SetCursor(b.Repo, h.Bank.Hex(), pb.EventTypeERC20, latest)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), prev.Number)
+	block, err = bridge.GetCursor(b.Repo, h.Bank.Hex(), pb.EventTypeERC20)
+	require.NoError(t, err)
+	require.Equal(t, latest, block.Number)
+}
+
+func TestMultipleBanks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	bank2, err := h.DeployBank()
+	require.NoError(t, err)
+
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+	rc2, err := rc.ForBank(bank2.Hex())
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	_, err = bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), bridge.WithBanks(&rc))
+	require.ErrorIs(t, err, bridge.ErrDuplicatedBank)
+
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), bridge.WithBanks(&rc2))
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+	require.Len(t, b.Banks, 2)
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	// the id 0 is deposited to both banks, and minted for both
+	require.NoError(t, h.DepositERC20(ctx, 10))
+	require.NoError(t, h.DepositERC20To(ctx, bank2, 20))
+
+	for _, bk := range b.Banks {
+		bk.ConfirmedBlockERC20.Number, err = b.FetchERC20(ctx, bk)
+		require.NoError(t, err)
+	}
+
+	h.Mine(1)
+	require.Eventually(t, func() bool {
+		st, err := b.Status()
+		return err == nil && st.InflightERC20 == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	balance, err := h.BalanceOf(ctx, h.ERC20Out, h.UserAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(30), balance.Int64())
+
+	for _, bank := range []string{h.Bank.Hex(), bank2.Hex()} {
+		e, err := b.NewEvent(pb.EventTypeERC20, bank, "0")
+		require.NoError(t, err)
+		require.NoError(t, b.Repo.GetEvent(e))
+		require.Equal(t, pb.EventStatus_SUCCEEDED, e.GetStatus())
+	}
+	_, err = b.NewEvent(pb.EventTypeERC20, "", "0")
+	require.ErrorIs(t, err, bridge.ErrBankRequired)
+
+	st, err := b.Status()
+	require.NoError(t, err)
+	require.Len(t, st.Banks, 2)
+}
+
+func TestLargeTokenID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.NFTIn.Hex(), Outaddr: h.NFTOut.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	// the hashed token id, far beyond uint64
+	tokenid := crypto.Keccak256Hash([]byte("hashed")).Big()
+	require.NoError(t, h.DepositNFT(ctx, tokenid))
+
+	_, err = b.FetchNFT(ctx, b.Banks[0])
+	require.NoError(t, err)
+	h.Mine(1)
+	require.Eventually(t, func() bool {
+		st, err := b.Status()
+		return err == nil && st.InflightNFT == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	owner, err := h.OwnerOf(ctx, h.NFTOut, tokenid)
+	require.NoError(t, err)
+	require.Equal(t, h.UserAddress(), owner)
+
+	e, err := b.NewEvent(pb.EventTypeNFT, "", "0")
+	require.NoError(t, err)
+	require.NoError(t, b.Repo.GetEvent(e))
+	require.Equal(t, tokenid.String(), e.(*pb.EventNFTDeposited).Tokenid)
+	require.Equal(t, pb.EventStatus_SUCCEEDED, e.GetStatus())
+}
+
+// failingSender fails the sends while set, the confirmation is left to the client
+type failingSender struct {
+	*client.Client
+	fail int32
+}
+
+func (s *failingSender) SendTx(ctx context.Context, tx interface{}) (string, error) {
+	if atomic.LoadInt32(&s.fail) == 1 {
+		return "", errors.New("connection refused")
+	}
+	return s.Client.SendTx(ctx, tx)
+}
+
+func TestSendFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	sender := &failingSender{Client: &c, fail: 1}
+	confirmer := confirm.NewConfirmer(sender, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	done := make(chan struct{})
+	b.Run(ctx, done, 10*time.Millisecond)
+	defer b.Wait()
+	defer close(done)
+
+	// the unsent tx is not in flight, the pipeline goes on fetching the later deposits
+	for i := 0; i < 2; i++ {
+		require.NoError(t, h.DepositERC20(ctx, 10))
+		latest, err := rc.LatestBlockNumber(ctx)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			st, err := b.Status()
+			return err == nil && st.ConfirmedBlockERC20 >= latest && st.InflightERC20 == 0
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	e, err := b.NewEvent(pb.EventTypeERC20, "", "1")
+	require.NoError(t, err)
+	require.NoError(t, b.Repo.GetEvent(e))
+	require.NotEqual(t, pb.EventStatus_SUCCEEDED, e.GetStatus())
+
+	// retried once the node is back
+	atomic.StoreInt32(&sender.fail, 0)
+	_, err = b.RetryEvent(ctx, e)
+	require.NotErrorIs(t, err, bridge.ErrEventInflight)
+}
+
+func TestQueueFull(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	// the queue of the single tx, never dequeued
+	confirmer := confirm.NewConfirmer(&c, 1, confirm.WithWorkers(1), confirm.WithWorkerInterval(int64(time.Hour/time.Millisecond)))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB(), bridge.WithConfirmationBlocks(1))
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+	for i := 0; i < 2; i++ {
+		require.NoError(t, h.DepositERC20(ctx, 10))
+	}
+
+	h.Hold(true)
+	bk := b.Banks[0]
+	bk.ConfirmedBlockERC20.Number, err = b.FetchERC20(ctx, bk)
+	require.NoError(t, err)
+
+	// the second tx is sent but rejected by the queue, kept in flight rather than failed
+	st, err := b.Status()
+	require.NoError(t, err)
+	require.Equal(t, 2, st.InflightERC20)
+	require.Zero(t, st.RetryQueue)
+
+	e, err := b.NewEvent(pb.EventTypeERC20, "", "1")
+	require.NoError(t, err)
+	// never stored as failed, so that the operator has nothing to retry
+	_, err = b.RetryEvent(ctx, e)
+	require.ErrorIs(t, err, bridge.ErrEventNotFound)
+
+	// confirmed by the receipt
+	h.Mine(2)
+	require.Eventually(t, func() bool {
+		st, err := b.Status()
+		return err == nil && st.InflightERC20 == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, b.Repo.GetEvent(e))
+	require.Equal(t, pb.EventStatus_SUCCEEDED, e.GetStatus())
+	balance, err := h.BalanceOf(ctx, h.ERC20Out, h.UserAddress())
+	require.NoError(t, err)
+	require.Equal(t, int64(20), balance.Int64())
+}
+
+// rpcCount returns the number of the rpc calls observed, as scraped from the registry
+func rpcCount(t *testing.T, chain, method string) (count uint64) {
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+	for _, f := range families {
+		if f.GetName() != "bridge_rpc_duration_seconds" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := make(map[string]string)
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels[metrics.LabelChain] == chain && labels[metrics.LabelMethod] == method {
+				count += m.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return
+}
+
+func TestMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	sender := &failingSender{Client: &c}
+	confirmer := confirm.NewConfirmer(sender, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.ERC20Out.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	// the metrics are global, so the deltas are asserted
+	var (
+		token   = h.ERC20In.Hex()
+		fetched = metrics.EventsFetched.WithLabelValues(pb.EventTypeERC20, token)
+		minted  = metrics.EventsMinted.WithLabelValues(pb.EventTypeERC20, token)
+		failed  = metrics.EventsFailed.WithLabelValues(pb.EventTypeERC20, token)
+		before  = []float64{testutil.ToFloat64(fetched), testutil.ToFloat64(minted), testutil.ToFloat64(failed)}
+		sends   = rpcCount(t, metrics.ChainOut, "SendTransaction")
+	)
+
+	bk := b.Banks[0]
+	require.NoError(t, h.DepositERC20(ctx, 10))
+	bk.ConfirmedBlockERC20.Number, err = b.FetchERC20(ctx, bk)
+	require.NoError(t, err)
+	require.Equal(t, float64(1), testutil.ToFloat64(metrics.Inflight.WithLabelValues(pb.EventTypeERC20)))
+	require.Equal(t, sends+1, rpcCount(t, metrics.ChainOut, "SendTransaction"))
+
+	h.Mine(1)
+	require.Eventually(t, func() bool {
+		st, err := b.Status()
+		return err == nil && st.InflightERC20 == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Zero(t, testutil.ToFloat64(metrics.Inflight.WithLabelValues(pb.EventTypeERC20)))
+
+	// the next is failed by the send, the block of the cursor is fetched again
+	atomic.StoreInt32(&sender.fail, 1)
+	require.NoError(t, h.DepositERC20(ctx, 10))
+	bk.ConfirmedBlockERC20.Number, err = b.FetchERC20(ctx, bk)
+	require.NoError(t, err)
+
+	require.Equal(t, before[0]+3, testutil.ToFloat64(fetched))
+	require.Equal(t, before[1]+1, testutil.ToFloat64(minted))
+	require.Equal(t, before[2]+1, testutil.ToFloat64(failed))
+
+	// the failed call is counted in addition to its duration
+	calls, errs := rpcCount(t, metrics.ChainIn, "Probe"), metrics.RPCErrors.WithLabelValues(metrics.ChainIn, "Probe")
+	before = append(before, testutil.ToFloat64(errs))
+	metrics.ObserveRPC(metrics.ChainIn, "Probe", time.Now(), nil)
+	metrics.ObserveRPC(metrics.ChainIn, "Probe", time.Now(), errors.New("connection refused"))
+	require.Equal(t, calls+2, rpcCount(t, metrics.ChainIn, "Probe"))
+	require.Equal(t, before[3]+1, testutil.ToFloat64(errs))
+
+	// the gauges refreshed by the rpc calls
+	h.Mine(2)
+	require.NoError(t, b.CollectMetrics(ctx))
+	head, err := rc.LatestBlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, float64(head-bk.ConfirmedBlockERC20.Number), testutil.ToFloat64(metrics.CursorLag.WithLabelValues(pb.EventTypeERC20, bk.Address)))
+	require.Equal(t, float64(bk.ConfirmedBlockERC20.Number), testutil.ToFloat64(metrics.ConfirmedBlock.WithLabelValues(pb.EventTypeERC20, bk.Address)))
+
+	st, err := b.Status()
+	require.NoError(t, err)
+	require.Equal(t, float64(st.Nonce), testutil.ToFloat64(metrics.SignerNonce))
+	balance, err := c.BalanceAt(ctx, crypto.PubkeyToAddress(h.Relayer.PublicKey))
+	require.NoError(t, err)
+	value, _ := new(big.Float).SetInt(balance).Float64()
+	require.Equal(t, value, testutil.ToFloat64(metrics.SignerBalance))
+}
+
+func TestEstimateFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+
+	confirmer := confirm.NewConfirmer(&c, 256, confirm.WithWorkers(1), confirm.WithWorkerInterval(10), confirm.WithConfirmationBlock(1))
+	b, err := bridge.NewBridge(ctx, &c, &rc, &confirmer, h.RelayerKey(), db.NewMemDB())
+	require.NoError(t, err)
+	require.NoError(t, b.Start(ctx))
+	defer b.Close(cancel, 0, false)
+
+	// the bank has no mint, the estimate reverts
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.ERC20In.Hex(), Outaddr: h.Bank.Hex(), Intype: pb.Pair_ORIGINAL}))
+	require.NoError(t, b.Repo.PutPair(&pb.Pair{Inaddr: h.NFTIn.Hex(), Outaddr: h.NFTOut.Hex(), Intype: pb.Pair_ORIGINAL}))
+
+	require.NoError(t, h.DepositERC20(ctx, 10))
+	_, err = b.FetchERC20(ctx, b.Banks[0])
+	require.NoError(t, err)
+
+	// the nonce is not consumed, the next mint is not stuck behind the gap
+	st, err := b.Status()
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), st.Nonce)
+
+	require.NoError(t, h.DepositNFT(ctx, big.NewInt(1)))
+	_, err = b.FetchNFT(ctx, b.Banks[0])
+	require.NoError(t, err)
+	h.Mine(1)
+	require.Eventually(t, func() bool {
+		st, err := b.Status()
+		return err == nil && st.InflightNFT == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	owner, err := h.OwnerOf(ctx, h.NFTOut, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, h.UserAddress(), owner)
+}
+
+func TestPairChecker(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := NewHarness()
+	require.NoError(t, err)
+	c, err := h.Client()
+	require.NoError(t, err)
+	rc, err := h.ReadClient()
+	require.NoError(t, err)
+	denied, err := h.DeployDeniedToken()
+	require.NoError(t, err)
+
+	var (
+		checker = bridge.NewPairChecker(&rc, &c, h.Bridge, crypto.PubkeyToAddress(h.Relayer.PublicKey))
+		noCode  = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	)
+
+	// not whitelisted yet
+	_, err = checker.Check(ctx, h.ERC20In.Hex(), h.ERC20Out.Hex())
+	require.ErrorIs(t, err, bridge.ErrNotWhitelisted)
+
+	require.NoError(t, h.Whitelist(ctx))
+	typ, err := checker.Check(ctx, h.ERC20In.Hex(), h.ERC20Out.Hex())
+	require.NoError(t, err)
+	require.Equal(t, pb.EventTypeERC20, typ)
+	typ, err = checker.Check(ctx, h.NFTIn.Hex(), h.NFTOut.Hex())
+	require.NoError(t, err)
+	require.Equal(t, pb.EventTypeNFT, typ)
+
+	for _, tc := range []struct {
+		in, out common.Address
+		err     error
+	}{
+		{noCode, h.ERC20Out, bridge.ErrNotContract},
+		{h.ERC20In, noCode, bridge.ErrNotContract},
+		{h.ERC20Out, h.ERC20Out, bridge.ErrNotWhitelisted},
+		{h.ERC20In, h.Bank, bridge.ErrMintUnsupported},
+		{h.ERC20In, denied, bridge.ErrMintDenied},
+	} {
+		_, err = checker.Check(ctx, tc.in.Hex(), tc.out.Hex())
+		require.ErrorIs(t, err, tc.err, "in: %s, out: %s", tc.in.Hex(), tc.out.Hex())
+	}
+
+	// the invalid pair is not registered
+	repo := pb.NewRepository(db.NewMemDB())
+	_, err = bridge.SetPair(ctx, repo, bridge.PairSpec{Inaddr: h.ERC20In.Hex(), Outaddr: denied.Hex()}, checker)
+	require.ErrorIs(t, err, bridge.ErrMintDenied)
+	_, err = bridge.ImportPairs(ctx, repo, []bridge.PairSpec{{Inaddr: h.NFTIn.Hex(), Outaddr: h.NFTOut.Hex()}, {Inaddr: h.ERC20In.Hex(), Outaddr: h.Bank.Hex()}}, checker)
+	require.ErrorIs(t, err, bridge.ErrMintUnsupported)
+	pairs, err := repo.ListPairs()
+	require.NoError(t, err)
+	require.Empty(t, pairs)
+
+	_, err = bridge.SetPair(ctx, repo, bridge.PairSpec{Inaddr: h.ERC20In.Hex(), Outaddr: denied.Hex()}, bridge.SkipPairChecks)
+	require.NoError(t, err)
+}
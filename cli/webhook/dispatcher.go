@@ -0,0 +1,294 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/log"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+const (
+	POLL_INTERVAL    = 1 * time.Second
+	DELIVERY_TIMEOUT = 10 * time.Second
+)
+
+var (
+	DefaultPolicy = Policy{
+		MaxAttempts:    0,
+		InitialBackoff: 1 * time.Second,
+		MaxBackoff:     10 * time.Minute,
+	}
+)
+
+// Policy decides when the failed delivery is retried.
+// the n-th retry waits `InitialBackoff * 2^(n-1)`, capped by `MaxBackoff`
+type Policy struct {
+	// the delivery is dropped after the attempts, 0 retries forever
+	MaxAttempts    uint32
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func (p Policy) Validate() error {
+	if p.InitialBackoff <= 0 || p.MaxBackoff < p.InitialBackoff {
+		return fmt.Errorf("invalid webhook policy: initial-backoff(%s) must be positive and max-backoff(%s) must not be less", p.InitialBackoff, p.MaxBackoff)
+	}
+	return nil
+}
+
+func (p Policy) backoff(attempts uint32) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(2, float64(attempts-1))
+	if d > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(d)
+}
+
+// Dispatcher stores the notifications in the outbox of the db, then posts them to the sinks.
+// the delivery is removed only after the sink responds 2xx, so every notification is delivered at least once,
+// the receiver should dedupe by the `X-Bridge-Delivery` header
+type Dispatcher struct {
+	repo   *pb.Repository
+	sinks  map[string]Sink
+	policy Policy
+	client *http.Client
+	logger zerolog.Logger
+
+	mu     sync.Mutex
+	lastID uint64
+
+	wake chan struct{}
+	done chan struct{}
+}
+
+func NewDispatcher(repo *pb.Repository, sinks []Sink, opts ...Option) (*Dispatcher, error) {
+	d := &Dispatcher{
+		repo:   repo,
+		sinks:  make(map[string]Sink, len(sinks)),
+		policy: DefaultPolicy,
+		client: &http.Client{Timeout: DELIVERY_TIMEOUT},
+		logger: log.Webhook(""),
+		wake:   make(chan struct{}, 1),
+	}
+
+	for _, s := range sinks {
+		if err := s.Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := d.sinks[s.Name]; ok {
+			return nil, fmt.Errorf("%w: duplicated name(%s)", ErrInvalidSink, s.Name)
+		}
+		d.sinks[s.Name] = s
+	}
+
+	for i := 0; i < len(opts); i++ {
+		if err := opts[i].Apply(d); err != nil {
+			return nil, err
+		}
+	}
+
+	// the ids continue after the deliveries left by the last run
+	pending, err := repo.ListDeliveries()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range pending {
+		if m.Id > d.lastID {
+			d.lastID = m.Id
+		}
+	}
+	if len(pending) > 0 {
+		d.logger.Info().Msgf("%d deliveries are left in the outbox", len(pending))
+	}
+
+	return d, nil
+}
+
+// Enqueue stores the notification for each accepting sink, it is the `bridge.Listener`
+func (d *Dispatcher) Enqueue(n bridge.Notification) {
+	var body []byte
+	for _, name := range d.sinkNames() {
+		if !d.sinks[name].accepts(n) {
+			continue
+		}
+
+		if body == nil {
+			var err error
+			if body, err = json.Marshal(toPayload(n)); err != nil {
+				d.logger.Error().Msgf("failed to marshal notification(%v), err: %v", n, err)
+				return
+			}
+		}
+
+		m := pb.WebhookDelivery{
+			Id:            d.newID(time.Now()),
+			Sink:          name,
+			Payload:       body,
+			NextAttemptAt: n.CreatedAt.UTC(),
+			CreatedAt:     n.CreatedAt.UTC(),
+		}
+		if err := d.repo.PutDelivery(&m); err != nil {
+			d.logger.Error().Msgf("failed to store delivery(%d) to %s, err: %v", m.Id, name, err)
+		}
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start delivers the outbox in background until the context is done or closed
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.done = make(chan struct{})
+
+	go func() {
+		defer close(d.done)
+
+		ticker := time.NewTicker(POLL_INTERVAL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-d.wake:
+			}
+			if err := d.Deliver(ctx, time.Now()); err != nil {
+				d.logger.Warn().Msgf("failed to deliver outbox, err: %v", err)
+			}
+		}
+	}()
+}
+
+// Wait blocks until the background delivery stops
+func (d *Dispatcher) Wait() {
+	if d.done != nil {
+		<-d.done
+	}
+}
+
+// Deliver posts the due deliveries in the order of id per sink.
+// the rest to the sink wait behind the failed one without counting the attempt
+func (d *Dispatcher) Deliver(ctx context.Context, now time.Time) error {
+	ms, err := d.repo.ListDeliveries()
+	if err != nil {
+		return err
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Id < ms[j].Id })
+
+	blocked := make(map[string]bool)
+	for i := range ms {
+		m := &ms[i]
+		if blocked[m.Sink] {
+			continue
+		}
+		if m.NextAttemptAt.After(now) {
+			blocked[m.Sink] = true
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		sink, ok := d.sinks[m.Sink]
+		if !ok {
+			d.logger.Warn().Msgf("dropped delivery(%d), the sink(%s) is removed from the config", m.Id, m.Sink)
+			if err := d.repo.DeleteDelivery(m); err != nil {
+				return err
+			}
+			continue
+		}
+
+		m.Attempts++
+		if err := d.post(ctx, sink, m, now); err != nil {
+			blocked[m.Sink] = true
+			if err := d.fail(m, now, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := d.repo.DeleteDelivery(m); err != nil {
+			return err
+		}
+		d.logger.Debug().Msgf("delivered(%d) to %s, attempts: %d", m.Id, m.Sink, m.Attempts)
+	}
+	return nil
+}
+
+func (d *Dispatcher) post(ctx context.Context, sink Sink, m *pb.WebhookDelivery, now time.Time) error {
+	ctx, cancel := context.WithTimeout(ctx, DELIVERY_TIMEOUT)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.URL, bytes.NewReader(m.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Sign(sink.Secret, now, m.Payload))
+	req.Header.Set(HeaderDelivery, strconv.FormatUint(m.Id, 10))
+	req.Header.Set(HeaderAttempt, strconv.FormatUint(uint64(m.Attempts), 10))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.New(res.Status)
+	}
+	return nil
+}
+
+// fail schedules the next attempt, or drops the delivery after the max attempts
+func (d *Dispatcher) fail(m *pb.WebhookDelivery, now time.Time, err error) error {
+	if d.policy.MaxAttempts > 0 && m.Attempts >= d.policy.MaxAttempts {
+		d.logger.Error().Msgf("dropped delivery(%d) to %s after %d attempts, err: %v, payload: %s", m.Id, m.Sink, m.Attempts, err, m.Payload)
+		return d.repo.DeleteDelivery(m)
+	}
+
+	m.NextAttemptAt = now.Add(d.policy.backoff(m.Attempts)).UTC()
+	m.LastError = err.Error()
+	d.logger.Warn().Msgf("failed to deliver(%d) to %s, attempts: %d, next at: %s, err: %v", m.Id, m.Sink, m.Attempts, m.NextAttemptAt.Format(time.RFC3339), err)
+	return d.repo.PutDelivery(m)
+}
+
+// newID returns the increasing id based on the time, so that the ids are never reused after the restart
+func (d *Dispatcher) newID(now time.Time) uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	id := uint64(now.UnixNano())
+	if id <= d.lastID {
+		id = d.lastID + 1
+	}
+	d.lastID = id
+	return id
+}
+
+func (d *Dispatcher) sinkNames() []string {
+	names := make([]string, 0, len(d.sinks))
+	for name := range d.sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
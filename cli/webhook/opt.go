@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"net/http"
+)
+
+type Option interface {
+	Apply(*Dispatcher) error
+}
+
+type PolicyOpt Policy
+
+func (o PolicyOpt) Apply(d *Dispatcher) error {
+	if err := Policy(o).Validate(); err != nil {
+		return err
+	}
+	d.policy = Policy(o)
+	return nil
+}
+func WithPolicy(p Policy) PolicyOpt {
+	return PolicyOpt(p)
+}
+
+type HTTPClient struct {
+	c *http.Client
+}
+
+func (o HTTPClient) Apply(d *Dispatcher) error {
+	d.client = o.c
+	return nil
+}
+func WithHTTPClient(c *http.Client) HTTPClient {
+	return HTTPClient{c: c}
+}
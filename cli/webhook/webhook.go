@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+const (
+	HeaderSignature = "X-Bridge-Signature"
+	HeaderDelivery  = "X-Bridge-Delivery"
+	HeaderAttempt   = "X-Bridge-Attempt"
+)
+
+var (
+	ErrInvalidSink      = errors.New("invalid webhook sink")
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// Sink is the endpoint receiving the notifications, the empty filters accept all
+type Sink struct {
+	Name   string   `mapstructure:"name"`
+	URL    string   `mapstructure:"url"`
+	Secret string   `mapstructure:"secret"`
+	Types  []string `mapstructure:"types"`  // `erc20` or `nft`
+	Stages []string `mapstructure:"stages"` // `detected`, `sent`, `retried`, `succeeded`, `failed` or `paused`
+}
+
+func (s Sink) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("%w: empty name", ErrInvalidSink)
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w(%s): invalid url(%s)", ErrInvalidSink, s.Name, s.URL)
+	}
+	if s.Secret == "" {
+		return fmt.Errorf("%w(%s): empty secret", ErrInvalidSink, s.Name)
+	}
+	return nil
+}
+
+func (s Sink) accepts(n bridge.Notification) bool {
+	return contains(s.Types, n.Event.Type()) && contains(s.Stages, string(n.Stage))
+}
+
+func contains(filter []string, v string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if f == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Payload is the json body posted to the sinks
+type Payload struct {
+	Stage     string    `json:"stage"`
+	Type      string    `json:"type"`
+	Hash      string    `json:"hash,omitempty"`
+	Event     pb.Event  `json:"event"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toPayload(n bridge.Notification) Payload {
+	p := Payload{
+		Stage:     string(n.Stage),
+		Type:      n.Event.Type(),
+		Hash:      n.Hash,
+		Event:     n.Event,
+		CreatedAt: n.CreatedAt.UTC(),
+	}
+	if n.Err != nil {
+		p.Error = n.Err.Error()
+	}
+	return p
+}
+
+// Sign returns the signature header, `t=<unix time>,v1=<hex of hmac-sha256("<unix time>.<body>")>`.
+// the time is signed together, so that the receiver can reject the replayed request
+func Sign(secret string, at time.Time, body []byte) string {
+	ts := strconv.FormatInt(at.Unix(), 10)
+	return "t=" + ts + ",v1=" + mac(secret, ts, body)
+}
+
+// Verify checks the signature header of the body, rejects the one signed before the tolerance.
+// 0 tolerance skips the time check
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts, sig string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			ts = kv[1]
+		case "v1":
+			sig = kv[1]
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || sig == "" {
+		return fmt.Errorf("%w: malformed header", ErrInvalidSignature)
+	}
+	if !hmac.Equal([]byte(sig), []byte(mac(secret, ts, body))) {
+		return ErrInvalidSignature
+	}
+	if tolerance > 0 && now.Sub(time.Unix(unix, 0)) > tolerance {
+		return fmt.Errorf("%w: signed at %s, too old", ErrInvalidSignature, time.Unix(unix, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func mac(secret, ts string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(ts))
+	h.Write([]byte("."))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
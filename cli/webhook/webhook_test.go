@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tak1827/evm-bridge/cli/bridge"
+	"github.com/tak1827/evm-bridge/cli/db"
+	"github.com/tak1827/evm-bridge/cli/pb"
+)
+
+func TestSign(t *testing.T) {
+	var (
+		now  = time.Now()
+		body = []byte(`{"stage":"sent"}`)
+		sig  = Sign("secret", now, body)
+	)
+
+	require.NoError(t, Verify("secret", sig, body, now, time.Minute))
+	require.ErrorIs(t, Verify("other", sig, body, now, time.Minute), ErrInvalidSignature)
+	require.ErrorIs(t, Verify("secret", sig, []byte(`{}`), now, time.Minute), ErrInvalidSignature)
+	require.ErrorIs(t, Verify("secret", sig, body, now.Add(time.Hour), time.Minute), ErrInvalidSignature)
+	require.ErrorIs(t, Verify("secret", "v1=00", body, now, 0), ErrInvalidSignature)
+}
+
+func TestDeliver(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		mu       sync.Mutex
+		fail     = true
+		received []Payload
+		ids      []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := Verify("secret", r.Header.Get(HeaderSignature), body, time.Now(), time.Minute); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var p Payload
+		p.Event = &pb.EventERC20Deposited{}
+		require.NoError(t, json.Unmarshal(body, &p))
+		received = append(received, p)
+		ids = append(ids, r.Header.Get(HeaderDelivery))
+	}))
+	defer srv.Close()
+
+	var (
+		repo  = pb.NewRepository(db.NewMemDB())
+		sinks = []Sink{
+			{Name: "frontend", URL: srv.URL, Secret: "secret", Stages: []string{string(bridge.StageSucceeded), string(bridge.StageFailed)}},
+			{Name: "nft-only", URL: srv.URL, Secret: "secret", Types: []string{pb.EventTypeNFT}},
+		}
+		policy = Policy{InitialBackoff: time.Second, MaxBackoff: time.Minute}
+		now    = time.Now()
+	)
+
+	d, err := NewDispatcher(repo, sinks, WithPolicy(policy))
+	require.NoError(t, err)
+
+	e := &pb.EventERC20Deposited{Id: "7", Token: "0x01", Sender: "0x02", Amount: "100"}
+	d.Enqueue(bridge.Notification{Stage: bridge.StageSent, Hash: "0xaa", Event: e, CreatedAt: now})
+	d.Enqueue(bridge.Notification{Stage: bridge.StageFailed, Hash: "0xaa", Event: e, Err: errors.New("reverted"), CreatedAt: now})
+	d.Enqueue(bridge.Notification{Stage: bridge.StageSucceeded, Hash: "0xbb", Event: e, CreatedAt: now})
+
+	// only the accepted ones are stored
+	ms, err := repo.ListDeliveries()
+	require.NoError(t, err)
+	require.Len(t, ms, 2)
+
+	// the failed delivery is kept with the backoff, the rest to the sink waits without the attempt
+	require.NoError(t, d.Deliver(ctx, now))
+	ms, err = repo.ListDeliveries()
+	require.NoError(t, err)
+	require.Len(t, ms, 2)
+	require.Equal(t, uint32(1), ms[0].Attempts)
+	require.Equal(t, "503 Service Unavailable", ms[0].LastError)
+	require.Equal(t, uint32(0), ms[1].Attempts)
+
+	// not due yet
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+	require.NoError(t, d.Deliver(ctx, now))
+	require.Empty(t, received)
+
+	// survives the restart
+	ms, err = repo.ListDeliveries()
+	require.NoError(t, err)
+	d, err = NewDispatcher(repo, sinks, WithPolicy(policy))
+	require.NoError(t, err)
+	require.Equal(t, ms[1].Id, d.lastID)
+
+	require.NoError(t, d.Deliver(ctx, now.Add(time.Second)))
+	left, err := repo.ListDeliveries()
+	require.NoError(t, err)
+	require.Empty(t, left)
+
+	require.Len(t, received, 2)
+	require.Equal(t, []string{strconv.FormatUint(ms[0].Id, 10), strconv.FormatUint(ms[1].Id, 10)}, ids)
+	require.Equal(t, string(bridge.StageFailed), received[0].Stage)
+	require.Equal(t, "reverted", received[0].Error)
+	require.Equal(t, pb.EventTypeERC20, received[0].Type)
+	require.Equal(t, "100", received[0].Event.(*pb.EventERC20Deposited).Amount)
+	require.Equal(t, string(bridge.StageSucceeded), received[1].Stage)
+}
+
+func TestDropAfterMaxAttempts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	var (
+		repo = pb.NewRepository(db.NewMemDB())
+		now  = time.Now()
+	)
+
+	d, err := NewDispatcher(repo, []Sink{{Name: "s", URL: srv.URL, Secret: "secret"}}, WithPolicy(Policy{MaxAttempts: 2, InitialBackoff: time.Second, MaxBackoff: time.Second}))
+	require.NoError(t, err)
+
+	d.Enqueue(bridge.Notification{Stage: bridge.StageDetected, Event: &pb.EventNFTDeposited{Id: "1"}, CreatedAt: now})
+	require.NoError(t, d.Deliver(context.Background(), now))
+	require.NoError(t, d.Deliver(context.Background(), now.Add(time.Second)))
+
+	ms, err := repo.ListDeliveries()
+	require.NoError(t, err)
+	require.Empty(t, ms)
+
+	_, err = NewDispatcher(repo, []Sink{{Name: "s", URL: "ftp://x", Secret: "secret"}})
+	require.ErrorIs(t, err, ErrInvalidSink)
+}